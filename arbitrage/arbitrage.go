@@ -0,0 +1,328 @@
+// Package arbitrage provides functions to find combinations of bets that lock in a profit whatever
+// the outcome, either between the back and lay sides of the same outcomes or across markets whose
+// outcomes overlap.
+package arbitrage
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gustavooferreira/bfutils"
+	"github.com/gustavooferreira/bfutils/betting"
+)
+
+// Find returns all arbitrage opportunities in the markets, sorted by profit.
+// outcomes is the list of all possible outcomes. Dutching opportunities are only found for
+// combinations of selections that cover every outcome exactly once.
+// Stakes are sized to use as much of the volume available as possible and rounded down to the penny,
+// see betting.Currency.RoundStake.
+// Commission is charged on the net profit of each market, as the exchange does.
+func Find(outcomes []string, markets []Market, options Options) ([]Opportunity, error) {
+	if err := betting.CheckCommission(options.Commission); err != nil {
+		return nil, err
+	}
+
+	if err := betting.CheckCurrency(options.Currency); err != nil {
+		return nil, err
+	}
+
+	f, err := newFinder(outcomes, markets, options)
+	if err != nil {
+		return nil, err
+	}
+
+	opportunities := []Opportunity{}
+
+	// Back and lay the same outcomes
+	for _, x := range f.candidates {
+		if !available(x.back) {
+			continue
+		}
+
+		for _, y := range f.candidates {
+			if !available(y.lay) || x.key != y.key || x.back.Odd <= y.lay.Odd {
+				continue
+			}
+
+			legs := []leg{{candidate: x, betType: betting.BetType_Back}, {candidate: y, betType: betting.BetType_Lay}}
+			if opportunity, ok := f.evaluate(OpportunityType_BackLay, legs); ok {
+				opportunities = append(opportunities, opportunity)
+			}
+		}
+	}
+
+	// Dutching
+	opportunities = append(opportunities, f.dutch(betting.BetType_Back)...)
+	opportunities = append(opportunities, f.dutch(betting.BetType_Lay)...)
+
+	sort.SliceStable(opportunities, func(i, j int) bool {
+		return opportunities[i].Profit > opportunities[j].Profit
+	})
+
+	return opportunities, nil
+}
+
+// candidate represents a selection that can be part of an arbitrage opportunity.
+type candidate struct {
+	marketID    string
+	selectionID int64
+	// outcomes holds the sorted indexes of the outcomes in which the selection wins.
+	outcomes []int
+	// key uniquely identifies the set of outcomes.
+	key  string
+	back betting.PriceSize
+	lay  betting.PriceSize
+}
+
+func (c candidate) price(betType betting.BetType) betting.PriceSize {
+	if betType == betting.BetType_Back {
+		return c.back
+	}
+	return c.lay
+}
+
+func (c candidate) wins(outcome int) bool {
+	for _, o := range c.outcomes {
+		if o == outcome {
+			return true
+		}
+	}
+	return false
+}
+
+// leg represents a bet to be placed on a candidate.
+type leg struct {
+	candidate candidate
+	betType   betting.BetType
+}
+
+type finder struct {
+	outcomesCount int
+	candidates    []candidate
+	options       Options
+}
+
+func newFinder(outcomes []string, markets []Market, options Options) (*finder, error) {
+	outcomeIndex := map[string]int{}
+	for i, outcome := range outcomes {
+		if _, ok := outcomeIndex[outcome]; ok {
+			return nil, fmt.Errorf("outcome [%s] is duplicated", outcome)
+		}
+		outcomeIndex[outcome] = i
+	}
+
+	f := &finder{outcomesCount: len(outcomes), options: options}
+
+	for _, market := range markets {
+		for _, selection := range market.Selections {
+			if len(selection.Outcomes) == 0 {
+				return nil, fmt.Errorf("selection [%d] in market [%s] has no outcomes", selection.ID, market.ID)
+			}
+
+			c := candidate{marketID: market.ID, selectionID: selection.ID, back: selection.Back, lay: selection.Lay}
+
+			seen := map[int]bool{}
+			for _, outcome := range selection.Outcomes {
+				index, ok := outcomeIndex[outcome]
+				if !ok {
+					return nil, fmt.Errorf("outcome [%s] of selection [%d] in market [%s] is unknown",
+						outcome, selection.ID, market.ID)
+				}
+				if !seen[index] {
+					seen[index] = true
+					c.outcomes = append(c.outcomes, index)
+				}
+			}
+
+			sort.Ints(c.outcomes)
+			keys := make([]string, len(c.outcomes))
+			for i, o := range c.outcomes {
+				keys[i] = strconv.Itoa(o)
+			}
+			c.key = strings.Join(keys, ",")
+
+			for _, price := range []betting.PriceSize{c.back, c.lay} {
+				if !available(price) {
+					continue
+				}
+
+				match, _, err := bfutils.FindOdd(price.Odd)
+				if err != nil {
+					return nil, err
+				}
+				if !match {
+					return nil, fmt.Errorf("odd provided [%f] does not exist in the ladder", price.Odd)
+				}
+			}
+
+			f.candidates = append(f.candidates, c)
+		}
+	}
+
+	return f, nil
+}
+
+// dutch returns the opportunities found by betting on sets of selections that cover all outcomes.
+func (f *finder) dutch(betType betting.BetType) []Opportunity {
+	var opportunityType OpportunityType = OpportunityType_DutchBack
+	if betType == betting.BetType_Lay {
+		opportunityType = OpportunityType_DutchLay
+	}
+
+	// Keep only the best price for each set of outcomes
+	best := map[string]candidate{}
+	keys := []string{}
+	for _, c := range f.candidates {
+		price := c.price(betType)
+		if !available(price) {
+			continue
+		}
+
+		current, ok := best[c.key]
+		if !ok {
+			keys = append(keys, c.key)
+		}
+		if !ok || (betType == betting.BetType_Back && price.Odd > current.back.Odd) ||
+			(betType == betting.BetType_Lay && price.Odd < current.lay.Odd) {
+			best[c.key] = c
+		}
+	}
+
+	// Candidates indexed by outcome
+	byOutcome := make([][]candidate, f.outcomesCount)
+	for _, key := range keys {
+		c := best[key]
+		for _, o := range c.outcomes {
+			byOutcome[o] = append(byOutcome[o], c)
+		}
+	}
+
+	opportunities := []Opportunity{}
+	covered := make([]bool, f.outcomesCount)
+	chosen := []leg{}
+
+	var search func(invOddsSum float64)
+	search = func(invOddsSum float64) {
+		next := -1
+		for o := range covered {
+			if !covered[o] {
+				next = o
+				break
+			}
+		}
+
+		if next == -1 {
+			if (betType == betting.BetType_Back && invOddsSum < 1) || (betType == betting.BetType_Lay && invOddsSum > 1) {
+				legs := make([]leg, len(chosen))
+				copy(legs, chosen)
+				if opportunity, ok := f.evaluate(opportunityType, legs); ok {
+					opportunities = append(opportunities, opportunity)
+				}
+			}
+			return
+		}
+
+	candidates:
+		for _, c := range byOutcome[next] {
+			for _, o := range c.outcomes {
+				if covered[o] {
+					continue candidates
+				}
+			}
+
+			sum := invOddsSum + 1/c.price(betType).Odd
+			// Backing is only profitable while the sum of the inverse of the odds is lower than 1
+			if betType == betting.BetType_Back && sum >= 1 {
+				continue
+			}
+
+			for _, o := range c.outcomes {
+				covered[o] = true
+			}
+			chosen = append(chosen, leg{candidate: c, betType: betType})
+
+			search(sum)
+
+			chosen = chosen[:len(chosen)-1]
+			for _, o := range c.outcomes {
+				covered[o] = false
+			}
+		}
+	}
+
+	if f.outcomesCount > 0 {
+		search(0)
+	}
+
+	return opportunities
+}
+
+// evaluate sizes the legs so that the gross profit is the same whatever the outcome, and computes
+// the guaranteed profit after commission.
+// It returns false if the legs cannot be sized within the volume available and minimum stake,
+// or if there is no profit.
+func (f *finder) evaluate(opportunityType OpportunityType, legs []leg) (opportunity Opportunity, ok bool) {
+	// Every leg's stake is total/odd, where total is the same for all legs
+	maxTotal := math.Inf(1)
+	minTotal := 0.0
+	for _, l := range legs {
+		price := l.candidate.price(l.betType)
+		maxTotal = math.Min(maxTotal, price.Size*price.Odd)
+		minTotal = math.Max(minTotal, f.options.Currency.MinStake*price.Odd)
+	}
+
+	if maxTotal < minTotal {
+		return opportunity, false
+	}
+
+	opportunity.Type = opportunityType
+	for _, l := range legs {
+		price := l.candidate.price(l.betType)
+		amount := f.options.Currency.RoundStake(maxTotal / price.Odd)
+		if amount == 0 {
+			return opportunity, false
+		}
+
+		opportunity.Legs = append(opportunity.Legs, Leg{
+			MarketID:    l.candidate.marketID,
+			SelectionID: l.candidate.selectionID,
+			Bet:         betting.NewBet(l.betType, price.Odd, amount),
+		})
+	}
+
+	opportunity.Profit = math.Inf(1)
+	for o := 0; o < f.outcomesCount; o++ {
+		marketsPL := map[string]float64{}
+		for i, l := range legs {
+			bet := opportunity.Legs[i].Bet
+			if l.candidate.wins(o) {
+				marketsPL[l.candidate.marketID] += bet.WinPL
+			} else {
+				marketsPL[l.candidate.marketID] += bet.LosePL
+			}
+		}
+
+		pl := 0.0
+		for _, marketPL := range marketsPL {
+			if marketPL > 0 {
+				marketPL *= 1 - f.options.Commission
+			}
+			pl += marketPL
+		}
+
+		opportunity.Profit = math.Min(opportunity.Profit, pl)
+	}
+
+	if opportunity.Profit <= 0 {
+		return opportunity, false
+	}
+	return opportunity, true
+}
+
+// available returns true if there is volume available at the given odd.
+func available(price betting.PriceSize) bool {
+	return price.Odd > 0 && price.Size > 0
+}
@@ -0,0 +1,193 @@
+package arbitrage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/arbitrage"
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const amountEqualityThreshold = 0.01
+
+func TestFind(t *testing.T) {
+	tests := map[string]struct {
+		outcomes              []string
+		markets               []arbitrage.Market
+		options               arbitrage.Options
+		expectedOpportunities []arbitrage.Opportunity
+		expectedErr           bool
+	}{
+		"find arbitrage 1": {
+			outcomes:    []string{"A"},
+			options:     arbitrage.Options{Commission: 1},
+			expectedErr: true,
+		},
+		"find arbitrage 2": {
+			outcomes:    []string{"A", "A"},
+			expectedErr: true,
+		},
+		"find arbitrage 3": {
+			outcomes: []string{"A", "B"},
+			markets: []arbitrage.Market{
+				{ID: "1.1", Selections: []arbitrage.Selection{{ID: 1, Outcomes: []string{"C"}}}},
+			},
+			expectedErr: true,
+		},
+		"find arbitrage 4": {
+			outcomes: []string{"A", "B"},
+			markets: []arbitrage.Market{
+				{ID: "1.1", Selections: []arbitrage.Selection{{ID: 1}}},
+			},
+			expectedErr: true,
+		},
+		"find arbitrage 5": {
+			outcomes: []string{"A", "B"},
+			markets: []arbitrage.Market{
+				{ID: "1.1", Selections: []arbitrage.Selection{
+					{ID: 1, Outcomes: []string{"A"}, Back: betting.PriceSize{Odd: 3.01, Size: 10}},
+				}},
+			},
+			expectedErr: true,
+		},
+		"find arbitrage 6": {
+			outcomes: []string{"A", "B"},
+			markets: []arbitrage.Market{
+				{ID: "1.1", Selections: []arbitrage.Selection{
+					{ID: 1, Outcomes: []string{"A"}, Back: betting.PriceSize{Odd: 3, Size: 100}, Lay: betting.PriceSize{Odd: 3.1, Size: 100}},
+					{ID: 2, Outcomes: []string{"B"}, Back: betting.PriceSize{Odd: 1.4, Size: 100}, Lay: betting.PriceSize{Odd: 1.5, Size: 100}},
+				}},
+			},
+			expectedOpportunities: []arbitrage.Opportunity{},
+		},
+		"find arbitrage 7": {
+			outcomes: []string{"A", "B"},
+			markets: []arbitrage.Market{
+				{ID: "1.1", Selections: []arbitrage.Selection{
+					{ID: 1, Outcomes: []string{"A"}, Back: betting.PriceSize{Odd: 3.1, Size: 100}, Lay: betting.PriceSize{Odd: 3, Size: 100}},
+					{ID: 2, Outcomes: []string{"B"}},
+				}},
+			},
+			expectedOpportunities: []arbitrage.Opportunity{
+				{Type: arbitrage.OpportunityType_BackLay, Profit: 3.21, Legs: []arbitrage.Leg{
+					{MarketID: "1.1", SelectionID: 1, Bet: betting.Bet{Type: betting.BetType_Back, Odd: 3.1, Amount: 96.77}},
+					{MarketID: "1.1", SelectionID: 1, Bet: betting.Bet{Type: betting.BetType_Lay, Odd: 3, Amount: 100}},
+				}},
+			},
+		},
+		"find arbitrage 8": {
+			outcomes: []string{"A", "B", "C"},
+			markets: []arbitrage.Market{
+				{ID: "1.1", Selections: []arbitrage.Selection{
+					{ID: 1, Outcomes: []string{"A"}, Back: betting.PriceSize{Odd: 2.2, Size: 50}},
+					{ID: 2, Outcomes: []string{"B"}, Back: betting.PriceSize{Odd: 4, Size: 50}},
+					{ID: 3, Outcomes: []string{"C"}, Back: betting.PriceSize{Odd: 5, Size: 50}},
+				}},
+			},
+			options: arbitrage.Options{Commission: 0.05, Currency: betting.Currency_GBP},
+			expectedOpportunities: []arbitrage.Opportunity{
+				{Type: arbitrage.OpportunityType_DutchBack, Profit: 9.97, Legs: []arbitrage.Leg{
+					{MarketID: "1.1", SelectionID: 1, Bet: betting.Bet{Type: betting.BetType_Back, Odd: 2.2, Amount: 50}},
+					{MarketID: "1.1", SelectionID: 2, Bet: betting.Bet{Type: betting.BetType_Back, Odd: 4, Amount: 27.5}},
+					{MarketID: "1.1", SelectionID: 3, Bet: betting.Bet{Type: betting.BetType_Back, Odd: 5, Amount: 22}},
+				}},
+			},
+		},
+		"find arbitrage 9": {
+			outcomes: []string{"A", "B", "C"},
+			markets: []arbitrage.Market{
+				{ID: "1.1", Selections: []arbitrage.Selection{
+					{ID: 1, Outcomes: []string{"A"}, Back: betting.PriceSize{Odd: 2.2, Size: 50}},
+					{ID: 2, Outcomes: []string{"B"}, Back: betting.PriceSize{Odd: 4, Size: 50}},
+					{ID: 3, Outcomes: []string{"C"}, Back: betting.PriceSize{Odd: 5, Size: 50}},
+				}},
+			},
+			options:               arbitrage.Options{Currency: betting.Currency{Code: "GBP", MinStake: 30}},
+			expectedOpportunities: []arbitrage.Opportunity{},
+		},
+		"find arbitrage 10": {
+			outcomes: []string{"A", "B"},
+			markets: []arbitrage.Market{
+				{ID: "1.1", Selections: []arbitrage.Selection{
+					{ID: 1, Outcomes: []string{"A"}, Back: betting.PriceSize{Odd: 3.5, Size: 10}},
+				}},
+				{ID: "1.2", Selections: []arbitrage.Selection{
+					{ID: 7, Outcomes: []string{"A"}, Lay: betting.PriceSize{Odd: 3.2, Size: 20}},
+				}},
+			},
+			expectedOpportunities: []arbitrage.Opportunity{
+				{Type: arbitrage.OpportunityType_BackLay, Profit: 0.93, Legs: []arbitrage.Leg{
+					{MarketID: "1.1", SelectionID: 1, Bet: betting.Bet{Type: betting.BetType_Back, Odd: 3.5, Amount: 10}},
+					{MarketID: "1.2", SelectionID: 7, Bet: betting.Bet{Type: betting.BetType_Lay, Odd: 3.2, Amount: 10.93}},
+				}},
+			},
+		},
+		"find arbitrage 11": {
+			outcomes: []string{"A", "B"},
+			markets: []arbitrage.Market{
+				{ID: "1.1", Selections: []arbitrage.Selection{
+					{ID: 1, Outcomes: []string{"A"}, Back: betting.PriceSize{Odd: 3.5, Size: 10}},
+				}},
+				{ID: "1.2", Selections: []arbitrage.Selection{
+					{ID: 7, Outcomes: []string{"A"}, Lay: betting.PriceSize{Odd: 3.2, Size: 20}},
+				}},
+			},
+			options:               arbitrage.Options{Commission: 0.05},
+			expectedOpportunities: []arbitrage.Opportunity{},
+		},
+		"find arbitrage 12": {
+			outcomes: []string{"A", "B"},
+			markets: []arbitrage.Market{
+				{ID: "1.1", Selections: []arbitrage.Selection{
+					{ID: 1, Outcomes: []string{"A"}, Lay: betting.PriceSize{Odd: 1.8, Size: 10}},
+				}},
+				{ID: "1.2", Selections: []arbitrage.Selection{
+					{ID: 2, Outcomes: []string{"B"}, Lay: betting.PriceSize{Odd: 2, Size: 10}},
+				}},
+			},
+			expectedOpportunities: []arbitrage.Opportunity{
+				{Type: arbitrage.OpportunityType_DutchLay, Profit: 1, Legs: []arbitrage.Leg{
+					{MarketID: "1.1", SelectionID: 1, Bet: betting.Bet{Type: betting.BetType_Lay, Odd: 1.8, Amount: 10}},
+					{MarketID: "1.2", SelectionID: 2, Bet: betting.Bet{Type: betting.BetType_Lay, Odd: 2, Amount: 9}},
+				}},
+			},
+		},
+		"find arbitrage 13": {
+			outcomes:    []string{"A"},
+			options:     arbitrage.Options{Currency: betting.Currency{Code: "GBP", MinStake: -1}},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			opportunities, err := arbitrage.Find(test.outcomes, test.markets, test.options)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+			require.Len(t, opportunities, len(test.expectedOpportunities))
+
+			for i, opportunity := range opportunities {
+				expected := test.expectedOpportunities[i]
+				assert.Equal(t, expected.Type, opportunity.Type, "opportunity type field")
+				assert.InDelta(t, expected.Profit, opportunity.Profit, amountEqualityThreshold, "profit field")
+				require.Len(t, opportunity.Legs, len(expected.Legs))
+
+				for j, leg := range opportunity.Legs {
+					assert.Equal(t, expected.Legs[j].MarketID, leg.MarketID, "market ID field")
+					assert.Equal(t, expected.Legs[j].SelectionID, leg.SelectionID, "selection ID field")
+					assert.Equal(t, expected.Legs[j].Bet.Type, leg.Bet.Type, "bet type field")
+					assert.Equal(t, expected.Legs[j].Bet.Odd, leg.Bet.Odd, "bet odd field")
+					assert.InDelta(t, expected.Legs[j].Bet.Amount, leg.Bet.Amount, amountEqualityThreshold, "bet amount field")
+				}
+			}
+		})
+	}
+}
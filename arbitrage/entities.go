@@ -0,0 +1,55 @@
+package arbitrage
+
+import "github.com/gustavooferreira/bfutils/betting"
+
+// Market represents a market and the best prices available on each of its selections.
+type Market struct {
+	// Market ID.
+	ID string
+	// Selections in this market.
+	Selections []Selection
+}
+
+// Selection represents a selection in a market.
+type Selection struct {
+	// Selection ID.
+	ID int64
+	// Outcomes in which this selection wins.
+	// Outcomes are shared across markets, e.g., a runner winning a race is the same outcome in
+	// both the win market and the "to be placed" market.
+	Outcomes []string
+	// Best odd available to back and its volume.
+	// A zero odd or volume means there's nothing available to back.
+	Back betting.PriceSize
+	// Best odd available to lay and its volume.
+	// A zero odd or volume means there's nothing available to lay.
+	Lay betting.PriceSize
+}
+
+// Options represents the options used to find arbitrage opportunities.
+type Options struct {
+	// Commission charged on the net profit of each market, as a decimal.
+	Commission float64
+	// Stake rules of the currency used. The zero value doesn't enforce a minimum stake.
+	Currency betting.Currency
+}
+
+// Leg represents one of the bets that make an arbitrage opportunity.
+type Leg struct {
+	// Market ID.
+	MarketID string
+	// Selection ID.
+	SelectionID int64
+	// Bet to place.
+	Bet betting.Bet
+}
+
+// Opportunity represents a combination of bets that locks in a profit, whatever the outcome.
+type Opportunity struct {
+	// Type of arbitrage.
+	Type OpportunityType
+	// Bets to place.
+	Legs []Leg
+	// Guaranteed profit, after commission.
+	Profit float64
+}
@@ -0,0 +1,18 @@
+package arbitrage
+
+// OpportunityType represents the type of arbitrage opportunity.
+type OpportunityType uint
+
+const (
+	// OpportunityType_BackLay represents backing and laying the same outcomes at a lower odd.
+	OpportunityType_BackLay = iota + 1
+	// OpportunityType_DutchBack represents backing a set of selections that cover all outcomes.
+	OpportunityType_DutchBack
+	// OpportunityType_DutchLay represents laying a set of selections that cover all outcomes.
+	OpportunityType_DutchLay
+)
+
+// String returns the string representation of OpportunityType.
+func (ot OpportunityType) String() string {
+	return [...]string{"", "BackLay", "DutchBack", "DutchLay"}[ot]
+}
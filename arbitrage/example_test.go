@@ -0,0 +1,44 @@
+package arbitrage_test
+
+import (
+	"fmt"
+
+	"github.com/gustavooferreira/bfutils/arbitrage"
+	"github.com/gustavooferreira/bfutils/betting"
+)
+
+// This example finds a dutching opportunity where backing every runner in a market
+// locks in a profit.
+func Example_a() {
+	outcomes := []string{"Home", "Draw", "Away"}
+
+	markets := []arbitrage.Market{
+		{
+			ID: "1.100",
+			Selections: []arbitrage.Selection{
+				{ID: 1, Outcomes: []string{"Home"}, Back: betting.PriceSize{Odd: 2.2, Size: 50}},
+				{ID: 2, Outcomes: []string{"Draw"}, Back: betting.PriceSize{Odd: 4, Size: 50}},
+				{ID: 3, Outcomes: []string{"Away"}, Back: betting.PriceSize{Odd: 5, Size: 50}},
+			},
+		},
+	}
+
+	opportunities, err := arbitrage.Find(outcomes, markets, arbitrage.Options{Commission: 0.05, Currency: betting.Currency_GBP})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, opportunity := range opportunities {
+		fmt.Printf("%s opportunity with a profit of £%.2f\n", opportunity.Type, opportunity.Profit)
+		for _, leg := range opportunity.Legs {
+			fmt.Printf("Market [%s] selection [%d]: {%s} bet at {%.2f} for £%.2f\n",
+				leg.MarketID, leg.SelectionID, leg.Bet.Type, leg.Bet.Odd, leg.Bet.Amount)
+		}
+	}
+
+	// Output:
+	// DutchBack opportunity with a profit of £9.97
+	// Market [1.100] selection [1]: {Back} bet at {2.20} for £50.00
+	// Market [1.100] selection [2]: {Back} bet at {4.00} for £27.50
+	// Market [1.100] selection [3]: {Back} bet at {5.00} for £22.00
+}
@@ -9,6 +9,21 @@ import (
 	"github.com/gustavooferreira/bfutils/internal"
 )
 
+// NewBet returns a new Bet with its P&L computed as if it was the only bet in the selection.
+func NewBet(betType BetType, odd float64, amount float64) Bet {
+	bet := Bet{Type: betType, Odd: odd, Amount: amount}
+
+	if betType == BetType_Back {
+		bet.WinPL = amount * (odd - 1)
+		bet.LosePL = -amount
+	} else if betType == BetType_Lay {
+		bet.WinPL = -amount * (odd - 1)
+		bet.LosePL = amount
+	}
+
+	return bet
+}
+
 // FreeBetDecimal returns the P&L multiplier factor.
 // Example: back:4 lay:2 the multiplier factor is 2, which means if you back at odd 4 with £10
 // and lay at odd 2 with £10 you secure a free bet of 2 * £10 = £20
@@ -93,13 +108,9 @@ func SelectionIsEdged(bets []Bet) (bool, error) {
 		}
 
 		// Check Odd is valid
-		match, _, err := bfutils.FindOdd(bet.Odd)
-		if err != nil {
+		if err := checkBetOdd(bet); err != nil {
 			return false, err
 		}
-		if !match {
-			return false, fmt.Errorf("odd provided [%f] does not exist in the ladder", bet.Odd)
-		}
 
 		if bet.Type == BetType_Back {
 			backAvgOdd = (backAvgOdd*backAmount + bet.Odd*bet.Amount) / (backAmount + bet.Amount)
@@ -119,17 +130,22 @@ func SelectionIsEdged(bets []Bet) (bool, error) {
 }
 
 // GreenBookSelection computes what bet to make in order to greenbook a selection.
+// Starting price bets are included as if matched at the projected starting price of the selection.
 func GreenBookSelection(selection Selection) (bet Bet, err error) {
 	layAvgOdd := 0.0
 	layAmount := 0.0
 	backAvgOdd := 0.0
 	backAmount := 0.0
 
-	bets := selection.Bets
 	currentBackOdd := selection.CurrentBackOdd
 	currentLayOdd := selection.CurrentLayOdd
 
-	if bets == nil || len(bets) == 0 {
+	bets, err := selectionBets(selection)
+	if err != nil {
+		return bet, err
+	}
+
+	if len(bets) == 0 {
 		return bet, fmt.Errorf("no bets in this selection")
 	}
 
@@ -157,13 +173,9 @@ func GreenBookSelection(selection Selection) (bet Bet, err error) {
 		}
 
 		// Check Odd is valid
-		match, _, err := bfutils.FindOdd(b.Odd)
-		if err != nil {
+		if err := checkBetOdd(b); err != nil {
 			return bet, err
 		}
-		if !match {
-			return bet, fmt.Errorf("odd provided [%f] does not exist in the ladder", b.Odd)
-		}
 
 		if b.Type == BetType_Back {
 			backAvgOdd = (backAvgOdd*backAmount + b.Odd*b.Amount) / (backAmount + b.Amount)
@@ -204,6 +216,7 @@ func GreenBookSelection(selection Selection) (bet Bet, err error) {
 // }
 
 // GreenBookAtAllOdds returns the ladder with P&L and volumed matched by bets.
+// Starting price bets are not taken into account, see GreenBookSelectionAtAllOdds.
 func GreenBookAtAllOdds(bets []Bet) ([]LadderStep, error) {
 	layAvgOdd := 0.0
 	layAmount := 0.0
@@ -218,13 +231,9 @@ func GreenBookAtAllOdds(bets []Bet) ([]LadderStep, error) {
 		}
 
 		// Check Odd is valid
-		match, _, err := bfutils.FindOdd(bet.Odd)
-		if err != nil {
+		if err := checkBetOdd(bet); err != nil {
 			return nil, err
 		}
-		if !match {
-			return nil, fmt.Errorf("odd provided [%f] does not exist in the ladder", bet.Odd)
-		}
 
 		oddsMatched[bet.Odd] += bet.Amount
 
@@ -263,6 +272,18 @@ func GreenBookAtAllOdds(bets []Bet) ([]LadderStep, error) {
 	return ladder, nil
 }
 
+// GreenBookSelectionAtAllOdds returns the ladder with P&L and volume matched by the bets in the selection,
+// including starting price bets projected at the near price, or at the far price if the near price is
+// not known, as done by GreenBookSelection.
+func GreenBookSelectionAtAllOdds(selection Selection) ([]LadderStep, error) {
+	bets, err := selectionBets(selection)
+	if err != nil {
+		return nil, err
+	}
+
+	return GreenBookAtAllOdds(bets)
+}
+
 // AlreadyEdgedError is the error used in case a selection is already edged.
 type AlreadyEdgedError struct {
 }
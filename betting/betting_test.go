@@ -417,6 +417,50 @@ func TestGreenBookSelection(t *testing.T) {
 			expectedWinPL:  1.67,
 			expectedLosePL: 1.67,
 		},
+		"calculate greenbook 14": {
+			selection: betting.Selection{
+				Bets: []betting.Bet{
+					{Type: betting.BetType_Back, Odd: 7.55, Amount: 10},
+				},
+				CurrentBackOdd: 4.9,
+				CurrentLayOdd:  5,
+			}, expectedErr: true,
+		},
+		"calculate greenbook 15": {
+			selection: betting.Selection{
+				Bets: []betting.Bet{
+					{Type: betting.BetType_Back, Odd: 7.55, Amount: 10, ReductionFactor: 0.245},
+				},
+				CurrentBackOdd: 4.9,
+				CurrentLayOdd:  5,
+			},
+			expectedBet:    betting.Bet{Type: betting.BetType_Lay, Odd: 5, Amount: 15.1},
+			expectedWinPL:  5.1,
+			expectedLosePL: 5.1,
+		},
+		"calculate greenbook 16": {
+			selection: betting.Selection{
+				BSPBets: []betting.BSPBet{
+					{Type: betting.BetType_Back, OrderType: betting.OrderType_MarketOnClose, Amount: 10},
+				},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3,
+			}, expectedErr: true,
+		},
+		"calculate greenbook 17": {
+			selection: betting.Selection{
+				BSPBets: []betting.BSPBet{
+					{Type: betting.BetType_Back, OrderType: betting.OrderType_MarketOnClose, Amount: 10},
+				},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3,
+				NearPrice:      4,
+				FarPrice:       4.5,
+			},
+			expectedBet:    betting.Bet{Type: betting.BetType_Lay, Odd: 3, Amount: 13.33},
+			expectedWinPL:  3.33,
+			expectedLosePL: 3.33,
+		},
 	}
 
 	for name, test := range tests {
@@ -531,3 +575,65 @@ func TestGreenBookAtAllOdds(t *testing.T) {
 		})
 	}
 }
+
+func TestGreenBookSelectionAtAllOdds(t *testing.T) {
+	bspBets := []betting.BSPBet{{Type: betting.BetType_Back, OrderType: betting.OrderType_MarketOnClose, Amount: 10}}
+
+	tests := map[string]struct {
+		selection          betting.Selection
+		index              int
+		expectedLadderStep betting.LadderStep
+		expectedErr        bool
+	}{
+		"calculate selection greenbook at all odds 1": {
+			selection:   betting.Selection{BSPBets: bspBets},
+			expectedErr: true,
+		},
+		"calculate selection greenbook at all odds 2": {
+			selection: betting.Selection{BSPBets: bspBets, NearPrice: 4, FarPrice: 4.5},
+			index:     149,
+			expectedLadderStep: betting.LadderStep{
+				Odd:         3,
+				GreenBookPL: 3.33,
+				VolMatched:  13.33,
+			},
+		},
+		"calculate selection greenbook at all odds 3": {
+			selection: betting.Selection{
+				Bets:     []betting.Bet{{Type: betting.BetType_Lay, Odd: 3, Amount: 5}},
+				BSPBets:  bspBets,
+				FarPrice: 4,
+			},
+			index: 149,
+			expectedLadderStep: betting.LadderStep{
+				Odd:         3,
+				GreenBookPL: 3.33,
+				VolMatched:  13.33,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			ladder, err := betting.GreenBookSelectionAtAllOdds(test.selection)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			value := ladder[test.index]
+
+			assert.Equal(t, test.expectedLadderStep.Odd, value.Odd)
+			assert.InDelta(t, test.expectedLadderStep.GreenBookPL, value.GreenBookPL, amountEqualityThreshold)
+			assert.InDelta(t, test.expectedLadderStep.VolMatched, value.VolMatched, amountEqualityThreshold)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package betting
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gustavooferreira/bfutils"
+)
+
+// ProjectBSPBets returns the bets matched if the starting price is sp.
+// LimitOnClose back bets are only matched if sp is greater than or equal to their limit odd, and
+// LimitOnClose lay bets if sp is lower than or equal to their limit odd. Bets not matched are left out.
+// Lay bets are converted from liability into backer's stake.
+// The bets returned have an Odd of sp, which might not exist in the ladder.
+func ProjectBSPBets(bspBets []BSPBet, sp float64) ([]Bet, error) {
+	if withinBoundary := bfutils.IsOddWithinBoundaries(sp); !withinBoundary {
+		return nil, fmt.Errorf("starting price [%f] is outside of trading range", sp)
+	}
+
+	bets := []Bet{}
+
+	for _, bspBet := range bspBets {
+		if bspBet.Type != BetType_Back && bspBet.Type != BetType_Lay {
+			return nil, fmt.Errorf("unknown bet type")
+		}
+
+		switch bspBet.OrderType {
+		case OrderType_MarketOnClose:
+		case OrderType_LimitOnClose:
+			if _, err := oddIndex(bspBet.LimitOdd); err != nil {
+				return nil, err
+			}
+
+			if (bspBet.Type == BetType_Back && sp < bspBet.LimitOdd) ||
+				(bspBet.Type == BetType_Lay && sp > bspBet.LimitOdd) {
+				continue
+			}
+		default:
+			return nil, fmt.Errorf("order type [%s] is not a starting price order", bspBet.OrderType)
+		}
+
+		amount := bspBet.Amount
+		if bspBet.Type == BetType_Lay {
+			amount /= sp - 1
+		}
+
+		bet := NewBet(bspBet.Type, sp, amount)
+		bet.OrderType = bspBet.OrderType
+		bets = append(bets, bet)
+	}
+
+	return bets, nil
+}
+
+// EstimateSP estimates the starting price of a selection, rounded to 2 decimal places, by finding
+// the odd at which the backer's stake wanting to be matched equals the backer's stake layers are
+// willing to take.
+// The near price takes into account starting price bets and the unmatched bets on the ladder,
+// while the far price only takes into account starting price bets.
+// Limits of LimitOnClose bets are not taken into account.
+func EstimateSP(book BSPBook) (nearPrice float64, farPrice float64, err error) {
+	if book.BackStake < 0 || book.LayLiability < 0 {
+		return 0, 0, fmt.Errorf("starting price volumes cannot be negative")
+	}
+
+	for _, levels := range [][]PriceSize{book.AvailableToBack, book.AvailableToLay} {
+		for _, level := range levels {
+			if _, err := oddIndex(level.Odd); err != nil {
+				return 0, 0, err
+			}
+
+			if level.Size < 0 {
+				return 0, 0, fmt.Errorf("size [%f] cannot be negative", level.Size)
+			}
+		}
+	}
+
+	if book.BackStake == 0 || book.LayLiability == 0 {
+		return 0, 0, fmt.Errorf("starting price bets are needed on both sides to estimate the starting price")
+	}
+
+	nearPrice = reconcileSP(book)
+	farPrice = reconcileSP(BSPBook{BackStake: book.BackStake, LayLiability: book.LayLiability})
+
+	return nearPrice, farPrice, nil
+}
+
+// reconcileSP returns the odd at which demand and supply of backer's stake meet.
+// Demand only grows, and supply only shrinks, as the odd increases, which allows using bisection.
+func reconcileSP(book BSPBook) float64 {
+	imbalance := func(odd float64) float64 {
+		demand := book.BackStake
+		for _, level := range book.AvailableToLay {
+			if level.Odd <= odd {
+				demand += level.Size
+			}
+		}
+
+		supply := book.LayLiability / (odd - 1)
+		for _, level := range book.AvailableToBack {
+			if level.Odd >= odd {
+				supply += level.Size
+			}
+		}
+
+		return demand - supply
+	}
+
+	low := bfutils.Odds[0]
+	high := bfutils.Odds[bfutils.OddsCount-1]
+
+	if imbalance(low) >= 0 {
+		return low
+	}
+
+	if imbalance(high) <= 0 {
+		return high
+	}
+
+	for i := 0; i < 100; i++ {
+		mid := (low + high) / 2
+		if imbalance(mid) < 0 {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	return math.Round(high*100) / 100
+}
@@ -0,0 +1,146 @@
+package betting_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectBSPBets(t *testing.T) {
+	tests := map[string]struct {
+		bspBets      []betting.BSPBet
+		sp           float64
+		expectedBets []betting.Bet
+		expectedErr  bool
+	}{
+		"project BSP bets 1": {sp: 0.5, expectedErr: true},
+		"project BSP bets 2": {
+			bspBets:     []betting.BSPBet{{Type: betting.BetType_Back, OrderType: betting.OrderType_Limit, Amount: 10}},
+			sp:          4,
+			expectedErr: true,
+		},
+		"project BSP bets 3": {
+			bspBets:     []betting.BSPBet{{Type: betting.BetType_Back, OrderType: betting.OrderType_LimitOnClose, LimitOdd: 3.01, Amount: 10}},
+			sp:          4,
+			expectedErr: true,
+		},
+		"project BSP bets 4": {
+			bspBets: []betting.BSPBet{
+				{Type: betting.BetType_Back, OrderType: betting.OrderType_MarketOnClose, Amount: 10},
+				{Type: betting.BetType_Lay, OrderType: betting.OrderType_MarketOnClose, Amount: 30},
+				{Type: betting.BetType_Back, OrderType: betting.OrderType_LimitOnClose, LimitOdd: 5, Amount: 10},
+				{Type: betting.BetType_Lay, OrderType: betting.OrderType_LimitOnClose, LimitOdd: 5, Amount: 15},
+				{Type: betting.BetType_Lay, OrderType: betting.OrderType_LimitOnClose, LimitOdd: 3, Amount: 15},
+			},
+			sp: 4,
+			expectedBets: []betting.Bet{
+				{Type: betting.BetType_Back, Odd: 4, Amount: 10, WinPL: 30, LosePL: -10, OrderType: betting.OrderType_MarketOnClose},
+				{Type: betting.BetType_Lay, Odd: 4, Amount: 10, WinPL: -30, LosePL: 10, OrderType: betting.OrderType_MarketOnClose},
+				{Type: betting.BetType_Lay, Odd: 4, Amount: 5, WinPL: -15, LosePL: 5, OrderType: betting.OrderType_LimitOnClose},
+			},
+		},
+		"project BSP bets 5": {
+			bspBets: []betting.BSPBet{
+				{Type: betting.BetType_Back, OrderType: betting.OrderType_LimitOnClose, LimitOdd: 4, Amount: 10},
+			},
+			sp: 4.37,
+			expectedBets: []betting.Bet{
+				{Type: betting.BetType_Back, Odd: 4.37, Amount: 10, WinPL: 33.7, LosePL: -10, OrderType: betting.OrderType_LimitOnClose},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			bets, err := betting.ProjectBSPBets(test.bspBets, test.sp)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+			require.Len(t, bets, len(test.expectedBets))
+
+			for i, bet := range bets {
+				assert.Equal(t, test.expectedBets[i].Type, bet.Type, "bet type field")
+				assert.Equal(t, test.expectedBets[i].OrderType, bet.OrderType, "order type field")
+				assert.InDelta(t, test.expectedBets[i].Odd, bet.Odd, float64EqualityThreshold, "bet odd field")
+				assert.InDelta(t, test.expectedBets[i].Amount, bet.Amount, amountEqualityThreshold, "bet amount field")
+				assert.InDelta(t, test.expectedBets[i].WinPL, bet.WinPL, amountEqualityThreshold, "Win P&L field")
+				assert.InDelta(t, test.expectedBets[i].LosePL, bet.LosePL, amountEqualityThreshold, "Lose P&L field")
+			}
+		})
+	}
+}
+
+func TestEstimateSP(t *testing.T) {
+	tests := map[string]struct {
+		book              betting.BSPBook
+		expectedNearPrice float64
+		expectedFarPrice  float64
+		expectedErr       bool
+	}{
+		"estimate SP 1": {book: betting.BSPBook{LayLiability: 300}, expectedErr: true},
+		"estimate SP 2": {book: betting.BSPBook{BackStake: -100, LayLiability: 300}, expectedErr: true},
+		"estimate SP 3": {
+			book:        betting.BSPBook{BackStake: 100, LayLiability: 300, AvailableToBack: []betting.PriceSize{{Odd: 5.05, Size: 10}}},
+			expectedErr: true,
+		},
+		"estimate SP 4": {
+			book:              betting.BSPBook{BackStake: 100, LayLiability: 300},
+			expectedNearPrice: 4,
+			expectedFarPrice:  4,
+		},
+		"estimate SP 5": {
+			book: betting.BSPBook{BackStake: 100, LayLiability: 300,
+				AvailableToBack: []betting.PriceSize{{Odd: 5, Size: 50}}},
+			expectedNearPrice: 5,
+			expectedFarPrice:  4,
+		},
+		"estimate SP 6": {
+			book: betting.BSPBook{BackStake: 100, LayLiability: 300,
+				AvailableToLay: []betting.PriceSize{{Odd: 3, Size: 60}}},
+			expectedNearPrice: 3,
+			expectedFarPrice:  4,
+		},
+		"estimate SP 7": {
+			book: betting.BSPBook{BackStake: 100, LayLiability: 300,
+				AvailableToBack: []betting.PriceSize{{Odd: 4.2, Size: 5}},
+				AvailableToLay:  []betting.PriceSize{{Odd: 4.1, Size: 5}}},
+			expectedNearPrice: 4.1,
+			expectedFarPrice:  4,
+		},
+		"estimate SP 8": {
+			book:              betting.BSPBook{BackStake: 1, LayLiability: 10000},
+			expectedNearPrice: 1000,
+			expectedFarPrice:  1000,
+		},
+		"estimate SP 9": {
+			book:              betting.BSPBook{BackStake: 300, LayLiability: 200},
+			expectedNearPrice: 1.67,
+			expectedFarPrice:  1.67,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			nearPrice, farPrice, err := betting.EstimateSP(test.book)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.InDelta(t, test.expectedNearPrice, nearPrice, float64EqualityThreshold, "near price field")
+			assert.InDelta(t, test.expectedFarPrice, farPrice, float64EqualityThreshold, "far price field")
+		})
+	}
+}
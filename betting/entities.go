@@ -14,16 +14,34 @@ type Bet struct {
 	// LosePL represents how much is the profit or loss in case this selection loses.
 	// This value is meant to be treated as read-only.
 	LosePL float64
+	// ReductionFactor represents the reduction factor, as a decimal, applied to the bet after other
+	// runners were removed from the market, compounded if there was more than one.
+	// Odd already reflects the reduction, which means it might not exist in the ladder.
+	ReductionFactor float64
+	// PriceReduced is true if Odd was reduced because of a non-runner, even if ReductionFactor is not known,
+	// e.g. for bets read from the cleared orders report. Odd might not exist in the ladder.
+	PriceReduced bool
+	// OrderType represents how the bet was matched.
+	// Bets matched at the starting price might have an Odd that does not exist in the ladder.
+	OrderType OrderType
 }
 
 // Selection represents a selection in a market.
 type Selection struct {
+	// Selection ID in the market.
+	ID int64
 	// Bets matched in this specific selection.
 	Bets []Bet
 	// Current back odd being offered.
 	CurrentBackOdd float64
 	// Current lay odd being offered.
 	CurrentLayOdd float64
+	// Starting price bets placed in this selection, which are yet to be matched.
+	BSPBets []BSPBet
+	// Projected starting price taking into account starting price bets and the unmatched bets on the ladder.
+	NearPrice float64
+	// Projected starting price taking into account starting price bets only.
+	FarPrice float64
 }
 
 // LadderStep represents a trading ladder.
@@ -35,3 +53,318 @@ type LadderStep struct {
 	// Volume matched by bets placed.
 	VolMatched float64
 }
+
+// HedgeTarget represents the odd at which a hedge bet has to be placed in order to close a
+// selection with a given P&L.
+type HedgeTarget struct {
+	// Type of the hedge bet: Back or Lay.
+	Type BetType
+	// Exact odd at which the hedge bet achieves the target P&L.
+	// This odd might not exist in the ladder.
+	Odd float64
+	// Nearest odd in the ladder towards 1.01.
+	OddFloor float64
+	// Nearest odd in the ladder towards 1000.
+	OddCeil float64
+	// Number of ticks the current odd, on the hedge side, needs to move in our favour to reach
+	// the nearest tradable odd that achieves the target P&L (OddFloor for Lay and OddCeil for Back).
+	// Zero or a negative number means the target can be achieved at the current odd.
+	Ticks int
+}
+
+// ExitRules represents the rules used to close a position.
+// Rules set to zero are disabled.
+type ExitRules struct {
+	// Number of ticks the price can move against the position before closing it.
+	StopLossTicks int
+	// Number of ticks the price needs to move in favour of the position before closing it.
+	TakeProfitTicks int
+	// P&L, after commission, at which the position is closed. It cannot be negative.
+	TakeProfitPL float64
+	// Number of ticks the price can move against the best price seen so far before closing the position.
+	TrailingStopTicks int
+	// Commission charged on profits, used by TakeProfitPL.
+	Commission float64
+}
+
+// ExitTrigger represents the odd at which a position is closed.
+type ExitTrigger struct {
+	// Reason for closing the position.
+	Reason ExitReason
+	// Odd, on the hedge side, that triggers the exit.
+	Odd float64
+	// Bet to be placed in order to close the position.
+	Bet Bet
+}
+
+// Currency represents the stake rules of a currency in the exchange.
+type Currency struct {
+	// ISO 4217 code of the currency.
+	Code string
+	// Minimum stake accepted by the exchange.
+	MinStake float64
+}
+
+// Currency_GBP represents the stake rules for the British Pound.
+var Currency_GBP = Currency{Code: "GBP", MinStake: 1}
+
+// Currency_EUR represents the stake rules for the Euro.
+var Currency_EUR = Currency{Code: "EUR", MinStake: 1}
+
+// RunnerEstimate represents the estimated probability of a runner winning and the odd on offer.
+type RunnerEstimate struct {
+	// Estimated probability of the runner winning, between 0 and 1.
+	Probability float64
+	// Odd on offer in the market.
+	Odd float64
+}
+
+// StakingOptions represents the options used to size bets.
+type StakingOptions struct {
+	// Bankroll available to bet.
+	Bankroll float64
+	// Fraction of the Kelly stake to bet, e.g. 0.5 for half Kelly.
+	KellyFraction float64
+	// Commission charged on profits, as a decimal.
+	Commission float64
+	// Stake rules of the currency used.
+	Currency Currency
+}
+
+// PriceSize represents an odd in the ladder and the volume available at that odd.
+type PriceSize struct {
+	// Odd in the market.
+	Odd float64
+	// Volume available.
+	Size float64
+}
+
+// RunnerResult represents the result of a selection once the market is settled.
+type RunnerResult struct {
+	// Status of the selection.
+	Status RunnerStatus
+	// DeadHeatFactor represents the fraction of the stake paid at full odds when a winner dead heats,
+	// e.g. 0.5 for a two way dead heat for first place.
+	// Zero means the selection won outright.
+	DeadHeatFactor float64
+}
+
+// BetSettlement represents the P&L of a settled bet.
+type BetSettlement struct {
+	// Bet settled.
+	Bet Bet
+	// P&L before commission.
+	GrossPL float64
+	// Share of the market commission allocated to this bet.
+	Commission float64
+	// P&L after commission.
+	NetPL float64
+}
+
+// SelectionSettlement represents the P&L of all bets settled in a selection.
+type SelectionSettlement struct {
+	// Selection ID in the market.
+	SelectionID int64
+	// Status of the selection.
+	Status RunnerStatus
+	// Bets settled, in the same order as in the selection.
+	Bets []BetSettlement
+	// P&L before commission.
+	GrossPL float64
+	// Share of the market commission allocated to this selection.
+	Commission float64
+	// P&L after commission.
+	NetPL float64
+}
+
+// MarketSettlement represents the P&L of all bets settled in a market.
+type MarketSettlement struct {
+	// Selections settled, in the same order as provided.
+	Selections []SelectionSettlement
+	// P&L before commission.
+	GrossPL float64
+	// Commission charged on the market.
+	Commission float64
+	// P&L after commission.
+	NetPL float64
+}
+
+// BSPBet represents a bet placed at the Betfair Starting Price.
+type BSPBet struct {
+	// Bet type: Back or Lay.
+	Type BetType
+	// Order type: LimitOnClose or MarketOnClose.
+	OrderType OrderType
+	// LimitOdd represents the worst odd accepted by a LimitOnClose bet, i.e., the minimum odd for back
+	// bets and the maximum odd for lay bets.
+	// It's ignored for MarketOnClose bets.
+	LimitOdd float64
+	// Amount represents the backer's stake for back bets and the liability for lay bets,
+	// as the exchange expects starting price bets to be placed.
+	Amount float64
+}
+
+// BSPBook represents the money waiting to be matched in a selection when the starting price is reconciled.
+type BSPBook struct {
+	// Total backer's stake of starting price back bets.
+	BackStake float64
+	// Total liability of starting price lay bets.
+	LayLiability float64
+	// Unmatched lay bets on the ladder, i.e., odds and volume available to back.
+	AvailableToBack []PriceSize
+	// Unmatched back bets on the ladder, i.e., odds and volume available to lay.
+	AvailableToLay []PriceSize
+}
+
+// Score represents the final score of a match.
+type Score struct {
+	// Goals scored by the home team.
+	Home int
+	// Goals scored by the away team.
+	Away int
+}
+
+// LineBet represents a bet in an Asian handicap or over/under market.
+type LineBet struct {
+	// Bet matched.
+	Bet Bet
+	// Side of the line the bet was placed on.
+	Side LineSide
+	// Line represents the handicap applied to the side for Asian handicap bets, or the number of
+	// goals for over/under bets. It must be a multiple of 0.25.
+	Line float64
+}
+
+// LineSelection represents a selection in an Asian handicap or over/under market.
+type LineSelection struct {
+	// Side of the line.
+	Side LineSide
+	// Line represents the handicap applied to the side for Asian handicap selections, or the number
+	// of goals for over/under selections. It must be a multiple of 0.25.
+	Line float64
+	// Bets matched in this specific selection.
+	Bets []Bet
+	// Current back odd being offered.
+	CurrentBackOdd float64
+	// Current lay odd being offered.
+	CurrentLayOdd float64
+}
+
+// LineHedge represents the bet that greenbooks a line selection and the resulting P&L.
+type LineHedge struct {
+	// Bet to be placed in order to greenbook the selection.
+	Bet Bet
+	// P&L for each outcome possible with the line of the selection.
+	OutcomesPL map[LineOutcome]float64
+}
+
+// Order represents an order placed in the market, which might be matched in full, in part or not at all.
+// Orders are meant to be created with NewOrder and updated through their methods, which keep the
+// sizes and status consistent.
+type Order struct {
+	// Bet ID given by the exchange.
+	BetID string
+	// Bet type: Back or Lay.
+	Type BetType
+	// Odd requested.
+	Odd float64
+	// What happens to the unmatched size when the market turns in-play.
+	PersistenceType PersistenceType
+	// Status of the order.
+	Status OrderStatus
+	// Size requested (backer's stake).
+	SizeRequested float64
+	// Size matched so far.
+	SizeMatched float64
+	// Size cancelled.
+	SizeCancelled float64
+	// Size lapsed.
+	SizeLapsed float64
+	// Average odd matched.
+	AvgPriceMatched float64
+	// Matches holds every fill of the order, in the order they happened.
+	Matches []PriceSize
+}
+
+// OrdersPL represents the P&L of a set of orders in a selection under different matching scenarios.
+type OrdersPL struct {
+	// P&L if the selection wins, taking into account the matched size only.
+	MatchedWinPL float64
+	// P&L if the selection loses, taking into account the matched size only.
+	MatchedLosePL float64
+	// P&L if the selection wins and all unmatched size gets matched at the odd requested.
+	AllMatchedWinPL float64
+	// P&L if the selection loses and all unmatched size gets matched at the odd requested.
+	AllMatchedLosePL float64
+	// Worst P&L if the selection wins, whatever part of the unmatched size gets matched.
+	WorstWinPL float64
+	// Worst P&L if the selection loses, whatever part of the unmatched size gets matched.
+	WorstLosePL float64
+}
+
+// Action represents an action to take on the market.
+type Action struct {
+	// Type of action.
+	Type ActionType
+	// Bet ID of the order affected. Empty when placing a new order.
+	BetID string
+	// Bet type of the order.
+	BetType BetType
+	// Odd of the order to place, or the new odd of the order replaced.
+	// Not used when cancelling.
+	Odd float64
+	// Size to place, to cancel, or the new size of the order replaced.
+	Size float64
+}
+
+// LadderOptions represents the options used to split a stake across several odds.
+type LadderOptions struct {
+	// Number of odds in the ladder, including the starting odd.
+	Ticks int
+	// How the stake is split across the odds.
+	Distribution LadderDistribution
+	// Ratio between the stakes of consecutive odds, between 0 and 1, used by LadderDistribution_Geometric.
+	Ratio float64
+	// Stake rules of the currency used.
+	Currency Currency
+}
+
+// LadderedBet represents a stake split across several odds.
+type LadderedBet struct {
+	// Bets to place, starting with the starting odd.
+	Bets []Bet
+	// Average odd achieved if all bets are matched.
+	AvgOdd float64
+	// Profit or loss of greenbooking the selection at the starting odd, if all bets are matched.
+	GreenBookPL float64
+}
+
+// HedgeExecution represents a hedge bet matched against the volume available in the ladder.
+type HedgeExecution struct {
+	// Type of the hedge bet: Back or Lay.
+	Type BetType
+	// Bets matched at each odd consumed, starting with the best odd.
+	Bets []Bet
+	// Total amount matched.
+	Amount float64
+	// Volume weighted average odd of the bets matched.
+	AvgOdd float64
+	// Profit or loss in case the selection wins, after the hedge.
+	WinPL float64
+	// Profit or loss in case the selection loses, after the hedge.
+	LosePL float64
+	// Filled is true if there is enough volume available to greenbook the selection.
+	Filled bool
+	// Slippage represents the profit lost compared with matching the same bets at the best odd available.
+	Slippage float64
+}
+
+// ScenarioPoint represents the greenbook P&L of a selection at a hypothetical odd.
+type ScenarioPoint struct {
+	// Number of ticks between Odd and the reference odd of the scenario.
+	Ticks int `json:"ticks"`
+	// Odd in the market.
+	Odd float64 `json:"odd"`
+	// Potential profit or loss in this selection in case of a greenbook operation at Odd.
+	GreenBookPL float64 `json:"greenbookPL"`
+}
@@ -14,3 +14,168 @@ const (
 func (bt BetType) String() string {
 	return [...]string{"", "Back", "Lay"}[bt]
 }
+
+// ExitReason represents the reason why a position is closed.
+type ExitReason uint
+
+const (
+	// ExitReason_StopLoss represents a stop loss exit.
+	ExitReason_StopLoss = iota + 1
+	// ExitReason_TrailingStop represents a trailing stop exit.
+	ExitReason_TrailingStop
+	// ExitReason_TakeProfit represents a take profit exit.
+	ExitReason_TakeProfit
+)
+
+// String returns the string representation of ExitReason.
+func (er ExitReason) String() string {
+	return [...]string{"", "StopLoss", "TrailingStop", "TakeProfit"}[er]
+}
+
+// RunnerStatus represents the status of a selection once the market is settled.
+type RunnerStatus uint
+
+const (
+	// RunnerStatus_Winner represents a selection that won.
+	RunnerStatus_Winner = iota + 1
+	// RunnerStatus_Loser represents a selection that lost.
+	RunnerStatus_Loser
+	// RunnerStatus_Removed represents a selection removed from the market, i.e., a non-runner.
+	RunnerStatus_Removed
+)
+
+// String returns the string representation of RunnerStatus.
+func (rs RunnerStatus) String() string {
+	return [...]string{"", "Winner", "Loser", "Removed"}[rs]
+}
+
+// OrderType represents the type of order used to place a bet.
+type OrderType uint
+
+const (
+	// OrderType_Limit represents a bet placed at a given odd on the ladder.
+	// It's the zero value, so that bets are treated as limit orders unless stated otherwise.
+	OrderType_Limit = iota
+	// OrderType_LimitOnClose represents a bet placed at the starting price with a limit odd.
+	OrderType_LimitOnClose
+	// OrderType_MarketOnClose represents a bet placed at the starting price, whatever it is.
+	OrderType_MarketOnClose
+)
+
+// String returns the string representation of OrderType.
+func (ot OrderType) String() string {
+	return [...]string{"Limit", "LimitOnClose", "MarketOnClose"}[ot]
+}
+
+// LineSide represents the side of an Asian handicap or over/under line.
+type LineSide uint
+
+const (
+	// LineSide_Home represents the home team in an Asian handicap market.
+	LineSide_Home = iota + 1
+	// LineSide_Away represents the away team in an Asian handicap market.
+	LineSide_Away
+	// LineSide_Over represents over the line in an over/under market.
+	LineSide_Over
+	// LineSide_Under represents under the line in an over/under market.
+	LineSide_Under
+)
+
+// String returns the string representation of LineSide.
+func (ls LineSide) String() string {
+	return [...]string{"", "Home", "Away", "Over", "Under"}[ls]
+}
+
+// LineOutcome represents the result of a bet in an Asian handicap or over/under market.
+type LineOutcome uint
+
+const (
+	// LineOutcome_Win represents a bet that won in full.
+	LineOutcome_Win = iota + 1
+	// LineOutcome_HalfWin represents a bet that won half of the stake and had the other half returned.
+	LineOutcome_HalfWin
+	// LineOutcome_Push represents a bet that had the stake returned.
+	LineOutcome_Push
+	// LineOutcome_HalfLose represents a bet that lost half of the stake and had the other half returned.
+	LineOutcome_HalfLose
+	// LineOutcome_Lose represents a bet that lost in full.
+	LineOutcome_Lose
+)
+
+// String returns the string representation of LineOutcome.
+func (lo LineOutcome) String() string {
+	return [...]string{"", "Win", "HalfWin", "Push", "HalfLose", "Lose"}[lo]
+}
+
+// OrderStatus represents the status of an order in the market.
+type OrderStatus uint
+
+const (
+	// OrderStatus_Placed represents an order placed with nothing matched yet.
+	OrderStatus_Placed = iota + 1
+	// OrderStatus_PartiallyMatched represents an order with part of its size matched and part still unmatched.
+	OrderStatus_PartiallyMatched
+	// OrderStatus_Matched represents an order fully matched.
+	OrderStatus_Matched
+	// OrderStatus_Cancelled represents an order whose unmatched size was cancelled.
+	OrderStatus_Cancelled
+	// OrderStatus_Lapsed represents an order whose unmatched size lapsed.
+	OrderStatus_Lapsed
+)
+
+// String returns the string representation of OrderStatus.
+func (s OrderStatus) String() string {
+	return [...]string{"", "Placed", "PartiallyMatched", "Matched", "Cancelled", "Lapsed"}[s]
+}
+
+// PersistenceType represents what happens to the unmatched size of an order when the market turns in-play.
+type PersistenceType uint
+
+const (
+	// PersistenceType_Lapse represents an order whose unmatched size lapses when the market turns in-play.
+	PersistenceType_Lapse = iota + 1
+	// PersistenceType_Persist represents an order whose unmatched size persists in-play.
+	PersistenceType_Persist
+	// PersistenceType_MarketOnClose represents an order whose unmatched size is converted into a
+	// MarketOnClose starting price bet when the market turns in-play.
+	PersistenceType_MarketOnClose
+)
+
+// String returns the string representation of PersistenceType.
+func (pt PersistenceType) String() string {
+	return [...]string{"", "Lapse", "Persist", "MarketOnClose"}[pt]
+}
+
+// ActionType represents an action to take on the market.
+type ActionType uint
+
+const (
+	// ActionType_Place represents placing a new order.
+	ActionType_Place = iota + 1
+	// ActionType_Cancel represents cancelling the unmatched size of an order, in full or in part.
+	ActionType_Cancel
+	// ActionType_Replace represents moving the unmatched size of an order to a different odd.
+	ActionType_Replace
+)
+
+// String returns the string representation of ActionType.
+func (at ActionType) String() string {
+	return [...]string{"", "Place", "Cancel", "Replace"}[at]
+}
+
+// LadderDistribution represents how a stake is split across the odds of a ladder.
+type LadderDistribution uint
+
+const (
+	// LadderDistribution_Equal represents the same stake at every odd.
+	LadderDistribution_Equal = iota + 1
+	// LadderDistribution_Linear represents a stake decreasing linearly away from the starting odd.
+	LadderDistribution_Linear
+	// LadderDistribution_Geometric represents a stake decreasing geometrically away from the starting odd.
+	LadderDistribution_Geometric
+)
+
+// String returns the string representation of LadderDistribution.
+func (ld LadderDistribution) String() string {
+	return [...]string{"", "Equal", "Linear", "Geometric"}[ld]
+}
@@ -0,0 +1,209 @@
+package betting
+
+import (
+	"fmt"
+
+	"github.com/gustavooferreira/bfutils"
+	"github.com/gustavooferreira/bfutils/internal"
+)
+
+// ExitPlanner evaluates exit rules of a position against a stream of price updates.
+// Ticks based rules are relative to the current odd on the hedge side (CurrentLayOdd if the
+// position needs a lay bet to be hedged, CurrentBackOdd otherwise) at the time the planner is created.
+type ExitPlanner struct {
+	bets  []Bet
+	rules ExitRules
+
+	// hedgeType is the type of bet that closes the position.
+	hedgeType BetType
+	// against is 1 if the price moving towards 1000 goes against the position, -1 otherwise.
+	against int
+
+	// Indexes in the ladder. A negative number means the rule is disabled.
+	bestIndex       int
+	stopLossIndex   int
+	takeProfitIndex int
+	targetPLIndex   int
+}
+
+// NewExitPlanner returns a new ExitPlanner for the selection.
+func NewExitPlanner(selection Selection, rules ExitRules) (*ExitPlanner, error) {
+	if rules.StopLossTicks < 0 || rules.TakeProfitTicks < 0 || rules.TrailingStopTicks < 0 {
+		return nil, fmt.Errorf("number of ticks cannot be negative")
+	}
+
+	if rules.TakeProfitPL < 0 {
+		return nil, fmt.Errorf("take profit P&L [%f] cannot be negative", rules.TakeProfitPL)
+	}
+
+	bets, err := selectionBets(selection)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bets) == 0 {
+		return nil, fmt.Errorf("no bets in this selection")
+	}
+
+	pos, err := newPosition(bets)
+	if err != nil {
+		return nil, err
+	}
+
+	exposure := pos.exposure()
+	if internal.EqualWithTolerance(0.0, exposure) {
+		return nil, &AlreadyEdgedError{}
+	}
+
+	ep := &ExitPlanner{
+		bets:            bets,
+		rules:           rules,
+		stopLossIndex:   -1,
+		takeProfitIndex: -1,
+		targetPLIndex:   -1,
+	}
+
+	referenceOdd := selection.CurrentBackOdd
+	ep.hedgeType = BetType_Back
+	ep.against = -1
+	if exposure > 0 {
+		referenceOdd = selection.CurrentLayOdd
+		ep.hedgeType = BetType_Lay
+		ep.against = 1
+	}
+
+	ep.bestIndex, err = oddIndex(referenceOdd)
+	if err != nil {
+		return nil, err
+	}
+
+	if rules.StopLossTicks > 0 {
+		ep.stopLossIndex, _, err = bfutils.OddShift(bfutils.RoundType_Round, referenceOdd, ep.against*rules.StopLossTicks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rules.TakeProfitTicks > 0 {
+		ep.takeProfitIndex, _, err = bfutils.OddShift(bfutils.RoundType_Round, referenceOdd, -ep.against*rules.TakeProfitTicks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rules.TrailingStopTicks > 0 {
+		_, _, err = bfutils.OddShift(bfutils.RoundType_Round, referenceOdd, ep.against*rules.TrailingStopTicks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rules.TakeProfitPL != 0 {
+		target, err := TargetPLSelection(selection, rules.TakeProfitPL, rules.Commission)
+		if err != nil {
+			return nil, err
+		}
+
+		odd := target.OddCeil
+		if target.Type == BetType_Lay {
+			odd = target.OddFloor
+		}
+
+		ep.targetPLIndex, err = oddIndex(odd)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ep, nil
+}
+
+// Triggers returns the odds, on the hedge side, at which each exit rule is triggered given the
+// prices seen so far, along with the bet that would close the position at that odd.
+func (ep *ExitPlanner) Triggers() ([]ExitTrigger, error) {
+	triggers := []ExitTrigger{}
+
+	for _, t := range ep.triggerIndexes() {
+		odd := bfutils.Odds[t.index]
+		bet, err := GreenBookSelection(Selection{Bets: ep.bets, CurrentBackOdd: odd, CurrentLayOdd: odd})
+		if err != nil {
+			return nil, err
+		}
+
+		triggers = append(triggers, ExitTrigger{Reason: t.reason, Odd: odd, Bet: bet})
+	}
+
+	return triggers, nil
+}
+
+// Update feeds the planner with the current back and lay odds in the market.
+// If an exit rule is triggered, it returns the bet that closes the position at the current odds.
+// Stop losses take precedence over trailing stops, which take precedence over take profits.
+func (ep *ExitPlanner) Update(backOdd float64, layOdd float64) (trigger ExitTrigger, triggered bool, err error) {
+	backIndex, err := oddIndex(backOdd)
+	if err != nil {
+		return trigger, false, err
+	}
+
+	layIndex, err := oddIndex(layOdd)
+	if err != nil {
+		return trigger, false, err
+	}
+
+	currentIndex := backIndex
+	if ep.hedgeType == BetType_Lay {
+		currentIndex = layIndex
+	}
+
+	// Ratchet the best price seen so far
+	if ep.against*(currentIndex-ep.bestIndex) < 0 {
+		ep.bestIndex = currentIndex
+	}
+
+	for _, t := range ep.triggerIndexes() {
+		movedAgainst := ep.against * (currentIndex - t.index)
+		if (t.reason == ExitReason_TakeProfit && movedAgainst <= 0) ||
+			(t.reason != ExitReason_TakeProfit && movedAgainst >= 0) {
+
+			bet, err := GreenBookSelection(Selection{Bets: ep.bets, CurrentBackOdd: backOdd, CurrentLayOdd: layOdd})
+			if err != nil {
+				return trigger, false, err
+			}
+
+			return ExitTrigger{Reason: t.reason, Odd: bfutils.Odds[currentIndex], Bet: bet}, true, nil
+		}
+	}
+
+	return trigger, false, nil
+}
+
+type triggerIndex struct {
+	reason ExitReason
+	index  int
+}
+
+// triggerIndexes returns the ladder indexes of all enabled exit rules, sorted by precedence.
+func (ep *ExitPlanner) triggerIndexes() []triggerIndex {
+	indexes := []triggerIndex{}
+
+	if ep.stopLossIndex >= 0 {
+		indexes = append(indexes, triggerIndex{reason: ExitReason_StopLoss, index: ep.stopLossIndex})
+	}
+
+	if ep.rules.TrailingStopTicks > 0 {
+		index := ep.bestIndex + ep.against*ep.rules.TrailingStopTicks
+		if index >= 0 && index < bfutils.OddsCount {
+			indexes = append(indexes, triggerIndex{reason: ExitReason_TrailingStop, index: index})
+		}
+	}
+
+	if ep.takeProfitIndex >= 0 {
+		indexes = append(indexes, triggerIndex{reason: ExitReason_TakeProfit, index: ep.takeProfitIndex})
+	}
+
+	if ep.targetPLIndex >= 0 {
+		indexes = append(indexes, triggerIndex{reason: ExitReason_TakeProfit, index: ep.targetPLIndex})
+	}
+
+	return indexes
+}
@@ -0,0 +1,250 @@
+package betting_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExitPlanner(t *testing.T) {
+	tests := map[string]struct {
+		selection   betting.Selection
+		rules       betting.ExitRules
+		expectedErr bool
+	}{
+		"exit planner 1": {expectedErr: true},
+		"exit planner 2": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3,
+			},
+			rules:       betting.ExitRules{StopLossTicks: -1},
+			expectedErr: true,
+		},
+		"exit planner 3": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3,
+			},
+			rules:       betting.ExitRules{StopLossTicks: 500},
+			expectedErr: true,
+		},
+		"exit planner 4": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3,
+			},
+			rules:       betting.ExitRules{TakeProfitPL: 50},
+			expectedErr: true,
+		},
+		"exit planner 5": {
+			selection: betting.Selection{
+				Bets: []betting.Bet{
+					{Type: betting.BetType_Back, Odd: 2, Amount: 10},
+					{Type: betting.BetType_Lay, Odd: 2, Amount: 10},
+				},
+				CurrentBackOdd: 2,
+				CurrentLayOdd:  2.02,
+			},
+			rules:       betting.ExitRules{StopLossTicks: 5},
+			expectedErr: true,
+		},
+		"exit planner 6": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3,
+			},
+			rules: betting.ExitRules{StopLossTicks: 5, TakeProfitTicks: 10, TrailingStopTicks: 3, TakeProfitPL: 5},
+		},
+		"exit planner 7": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3,
+			},
+			rules:       betting.ExitRules{TakeProfitPL: -1},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			_, err := betting.NewExitPlanner(test.selection, test.rules)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+		})
+	}
+}
+
+func TestExitPlannerTriggers(t *testing.T) {
+	selection := betting.Selection{
+		Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+		CurrentBackOdd: 2.98,
+		CurrentLayOdd:  3,
+	}
+	rules := betting.ExitRules{StopLossTicks: 5, TakeProfitTicks: 10, TrailingStopTicks: 3, TakeProfitPL: 5}
+
+	planner, err := betting.NewExitPlanner(selection, rules)
+	require.NoError(t, err)
+
+	triggers, err := planner.Triggers()
+	require.NoError(t, err)
+
+	expected := []betting.ExitTrigger{
+		{Reason: betting.ExitReason_StopLoss, Odd: 3.25, Bet: betting.Bet{Type: betting.BetType_Lay, Odd: 3.25, Amount: 9.23, WinPL: -0.77, LosePL: -0.77}},
+		{Reason: betting.ExitReason_TrailingStop, Odd: 3.15, Bet: betting.Bet{Type: betting.BetType_Lay, Odd: 3.15, Amount: 9.52, WinPL: -0.48, LosePL: -0.48}},
+		{Reason: betting.ExitReason_TakeProfit, Odd: 2.8, Bet: betting.Bet{Type: betting.BetType_Lay, Odd: 2.8, Amount: 10.71, WinPL: 0.71, LosePL: 0.71}},
+		{Reason: betting.ExitReason_TakeProfit, Odd: 2, Bet: betting.Bet{Type: betting.BetType_Lay, Odd: 2, Amount: 15, WinPL: 5, LosePL: 5}},
+	}
+
+	require.Len(t, triggers, len(expected))
+
+	for i, trigger := range triggers {
+		assert.Equal(t, expected[i].Reason, trigger.Reason, "reason field")
+		assert.Equal(t, expected[i].Odd, trigger.Odd, "odd field")
+		assert.Equal(t, expected[i].Bet.Type, trigger.Bet.Type, "bet type field")
+		assert.Equal(t, expected[i].Bet.Odd, trigger.Bet.Odd, "bet odd field")
+		assert.InDelta(t, expected[i].Bet.Amount, trigger.Bet.Amount, amountEqualityThreshold, "bet amount field")
+		assert.InDelta(t, expected[i].Bet.WinPL, trigger.Bet.WinPL, amountEqualityThreshold, "Win P&L field")
+		assert.InDelta(t, expected[i].Bet.LosePL, trigger.Bet.LosePL, amountEqualityThreshold, "Lose P&L field")
+	}
+}
+
+func TestExitPlannerUpdate(t *testing.T) {
+	tests := map[string]struct {
+		selection         betting.Selection
+		rules             betting.ExitRules
+		prices            [][2]float64
+		expectedTriggered bool
+		expectedTrigger   betting.ExitTrigger
+		expectedErr       bool
+	}{
+		"exit planner update 1": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3,
+			},
+			rules:       betting.ExitRules{StopLossTicks: 5},
+			prices:      [][2]float64{{2.98, 3.01}},
+			expectedErr: true,
+		},
+		"exit planner update 2": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3,
+			},
+			rules:  betting.ExitRules{StopLossTicks: 5, TakeProfitTicks: 10},
+			prices: [][2]float64{{2.98, 3}, {3, 3.05}, {3.15, 3.2}, {2.9, 2.92}},
+		},
+		"exit planner update 3": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3,
+			},
+			rules:             betting.ExitRules{StopLossTicks: 5, TakeProfitTicks: 10},
+			prices:            [][2]float64{{3, 3.05}, {3.25, 3.3}},
+			expectedTriggered: true,
+			expectedTrigger: betting.ExitTrigger{
+				Reason: betting.ExitReason_StopLoss,
+				Odd:    3.3,
+				Bet:    betting.Bet{Type: betting.BetType_Lay, Odd: 3.3, Amount: 9.09, WinPL: -0.91, LosePL: -0.91},
+			},
+		},
+		"exit planner update 4": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3,
+			},
+			rules:             betting.ExitRules{StopLossTicks: 5, TakeProfitTicks: 10, TrailingStopTicks: 3},
+			prices:            [][2]float64{{2.96, 2.98}, {2.88, 2.9}, {2.92, 2.94}, {2.94, 2.96}},
+			expectedTriggered: true,
+			expectedTrigger: betting.ExitTrigger{
+				Reason: betting.ExitReason_TrailingStop,
+				Odd:    2.96,
+				Bet:    betting.Bet{Type: betting.BetType_Lay, Odd: 2.96, Amount: 10.14, WinPL: 0.14, LosePL: 0.14},
+			},
+		},
+		"exit planner update 5": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3,
+			},
+			rules:             betting.ExitRules{StopLossTicks: 5, TakeProfitTicks: 10, TrailingStopTicks: 3},
+			prices:            [][2]float64{{2.76, 2.78}},
+			expectedTriggered: true,
+			expectedTrigger: betting.ExitTrigger{
+				Reason: betting.ExitReason_TakeProfit,
+				Odd:    2.78,
+				Bet:    betting.Bet{Type: betting.BetType_Lay, Odd: 2.78, Amount: 10.79, WinPL: 0.79, LosePL: 0.79},
+			},
+		},
+		"exit planner update 6": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Lay, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 3,
+				CurrentLayOdd:  3.05,
+			},
+			rules:             betting.ExitRules{StopLossTicks: 2},
+			prices:            [][2]float64{{2.98, 3}, {2.96, 2.98}},
+			expectedTriggered: true,
+			expectedTrigger: betting.ExitTrigger{
+				Reason: betting.ExitReason_StopLoss,
+				Odd:    2.96,
+				Bet:    betting.Bet{Type: betting.BetType_Back, Odd: 2.96, Amount: 10.14, WinPL: -0.14, LosePL: -0.14},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			planner, err := betting.NewExitPlanner(test.selection, test.rules)
+			require.NoError(t, err)
+
+			var errBool bool
+			var errMsg string
+			var trigger betting.ExitTrigger
+			var triggered bool
+
+			for _, price := range test.prices {
+				trigger, triggered, err = planner.Update(price[0], price[1])
+				if err != nil {
+					errBool = true
+					errMsg = fmt.Sprintf(" - err: %s", err.Error())
+					break
+				}
+				if triggered {
+					break
+				}
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+			require.Equal(t, test.expectedTriggered, triggered, "triggered field")
+
+			assert.Equal(t, test.expectedTrigger.Reason, trigger.Reason, "reason field")
+			assert.Equal(t, test.expectedTrigger.Odd, trigger.Odd, "odd field")
+			assert.Equal(t, test.expectedTrigger.Bet.Type, trigger.Bet.Type, "bet type field")
+			assert.Equal(t, test.expectedTrigger.Bet.Odd, trigger.Bet.Odd, "bet odd field")
+			assert.InDelta(t, test.expectedTrigger.Bet.Amount, trigger.Bet.Amount, amountEqualityThreshold, "bet amount field")
+			assert.InDelta(t, test.expectedTrigger.Bet.WinPL, trigger.Bet.WinPL, amountEqualityThreshold, "Win P&L field")
+			assert.InDelta(t, test.expectedTrigger.Bet.LosePL, trigger.Bet.LosePL, amountEqualityThreshold, "Lose P&L field")
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package betting
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gustavooferreira/bfutils"
+)
+
+// LadderBet splits a stake across several consecutive odds in the ladder, starting at odd and
+// moving towards better prices, i.e., towards 1000 for back bets and towards 1.01 for lay bets.
+// odd must exist in the ladder. stake is the total amount to bet (backer's stake for lay bets).
+// Stakes are rounded down to the penny, with any pennies left over added to the bet at the
+// starting odd. An error is returned if any bet is lower than the minimum stake of the currency.
+func LadderBet(betType BetType, odd float64, stake float64, options LadderOptions) (ladder LadderedBet, err error) {
+	if err := CheckCurrency(options.Currency); err != nil {
+		return ladder, err
+	}
+
+	var direction int
+
+	switch betType {
+	case BetType_Back:
+		direction = 1
+	case BetType_Lay:
+		direction = -1
+	default:
+		return ladder, fmt.Errorf("unknown bet type")
+	}
+
+	match, _, err := bfutils.FindOdd(odd)
+	if err != nil {
+		return ladder, err
+	}
+	if !match {
+		return ladder, fmt.Errorf("odd provided [%f] does not exist in the ladder", odd)
+	}
+
+	if stake <= 0 {
+		return ladder, fmt.Errorf("stake [%f] must be positive", stake)
+	}
+
+	weights, err := ladderWeights(options)
+	if err != nil {
+		return ladder, err
+	}
+
+	totalWeight := 0.0
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+
+	amounts := make([]float64, len(weights))
+	allocated := 0.0
+	for i, weight := range weights {
+		amounts[i] = math.Floor(stake*weight/totalWeight*100+1e-6) / 100
+		allocated += amounts[i]
+	}
+	amounts[0] = math.Round((amounts[0]+stake-allocated)*100) / 100
+
+	ladder.Bets = make([]Bet, 0, len(amounts))
+	totalAmount := 0.0
+
+	for i, amount := range amounts {
+		_, tickOdd, err := bfutils.OddShift(bfutils.RoundType_Round, odd, direction*i)
+		if err != nil {
+			return LadderedBet{}, err
+		}
+
+		if amount < options.Currency.MinStake {
+			return LadderedBet{}, fmt.Errorf("stake [%f] at odd [%f] is lower than the minimum stake [%f]",
+				amount, tickOdd, options.Currency.MinStake)
+		}
+
+		ladder.Bets = append(ladder.Bets, NewBet(betType, tickOdd, amount))
+		ladder.AvgOdd = (ladder.AvgOdd*totalAmount + tickOdd*amount) / (totalAmount + amount)
+		totalAmount += amount
+	}
+
+	hedge, err := GreenBookSelection(Selection{Bets: ladder.Bets, CurrentBackOdd: odd, CurrentLayOdd: odd})
+	if _, ok := err.(*AlreadyEdgedError); ok {
+		return ladder, nil
+	} else if err != nil {
+		return LadderedBet{}, err
+	}
+
+	ladder.GreenBookPL = hedge.LosePL
+
+	return ladder, nil
+}
+
+// ladderWeights returns the weight of the stake at each odd of the ladder, starting at the starting odd.
+func ladderWeights(options LadderOptions) ([]float64, error) {
+	if options.Ticks <= 0 {
+		return nil, fmt.Errorf("ticks [%d] must be positive", options.Ticks)
+	}
+
+	weights := make([]float64, options.Ticks)
+
+	for i := range weights {
+		switch options.Distribution {
+		case LadderDistribution_Equal:
+			weights[i] = 1
+		case LadderDistribution_Linear:
+			weights[i] = float64(options.Ticks - i)
+		case LadderDistribution_Geometric:
+			if options.Ratio <= 0 || options.Ratio > 1 {
+				return nil, fmt.Errorf("ratio [%f] must be between 0 and 1", options.Ratio)
+			}
+			weights[i] = math.Pow(options.Ratio, float64(i))
+		default:
+			return nil, fmt.Errorf("unknown ladder distribution")
+		}
+	}
+
+	return weights, nil
+}
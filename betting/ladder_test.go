@@ -0,0 +1,102 @@
+package betting_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLadderBet(t *testing.T) {
+	equal := betting.LadderOptions{Ticks: 3, Distribution: betting.LadderDistribution_Equal, Currency: betting.Currency_GBP}
+
+	tests := map[string]struct {
+		betType        betting.BetType
+		odd            float64
+		stake          float64
+		options        betting.LadderOptions
+		expectedOdds   []float64
+		expectedStakes []float64
+		expectedAvgOdd float64
+		expectedPL     float64
+		expectedErr    bool
+	}{
+		"ladder bet 1": {odd: 3, stake: 30, options: equal, expectedErr: true},
+		"ladder bet 2": {betType: betting.BetType_Back, odd: 3.01, stake: 30, options: equal, expectedErr: true},
+		"ladder bet 3": {betType: betting.BetType_Back, odd: 3, stake: 0, options: equal, expectedErr: true},
+		"ladder bet 4": {betType: betting.BetType_Back, odd: 3, stake: 30,
+			options: betting.LadderOptions{Distribution: betting.LadderDistribution_Equal}, expectedErr: true},
+		"ladder bet 5": {betType: betting.BetType_Back, odd: 3, stake: 30,
+			options: betting.LadderOptions{Ticks: 3}, expectedErr: true},
+		"ladder bet 6": {betType: betting.BetType_Back, odd: 3, stake: 30,
+			options: betting.LadderOptions{Ticks: 3, Distribution: betting.LadderDistribution_Geometric}, expectedErr: true},
+		"ladder bet 7": {betType: betting.BetType_Back, odd: 3, stake: 2, options: equal, expectedErr: true},
+		"ladder bet 8": {betType: betting.BetType_Back, odd: 1000, stake: 30, options: equal, expectedErr: true},
+		"ladder bet 9": {
+			betType: betting.BetType_Back, odd: 3, stake: 30, options: equal,
+			expectedOdds: []float64{3, 3.05, 3.1}, expectedStakes: []float64{10, 10, 10},
+			expectedAvgOdd: 3.05, expectedPL: 0.5,
+		},
+		"ladder bet 10": {
+			betType: betting.BetType_Lay, odd: 3, stake: 30, options: equal,
+			expectedOdds: []float64{3, 2.98, 2.96}, expectedStakes: []float64{10, 10, 10},
+			expectedAvgOdd: 2.98, expectedPL: 0.2,
+		},
+		"ladder bet 11": {
+			betType: betting.BetType_Back, odd: 3, stake: 60,
+			options:      betting.LadderOptions{Ticks: 3, Distribution: betting.LadderDistribution_Linear},
+			expectedOdds: []float64{3, 3.05, 3.1}, expectedStakes: []float64{30, 20, 10},
+			expectedAvgOdd: 3.033333, expectedPL: 0.666667,
+		},
+		"ladder bet 12": {
+			betType: betting.BetType_Back, odd: 3, stake: 70,
+			options:      betting.LadderOptions{Ticks: 3, Distribution: betting.LadderDistribution_Geometric, Ratio: 0.5},
+			expectedOdds: []float64{3, 3.05, 3.1}, expectedStakes: []float64{40, 20, 10},
+			expectedAvgOdd: 3.028571, expectedPL: 0.666667,
+		},
+		"ladder bet 13": {
+			betType: betting.BetType_Back, odd: 3, stake: 10, options: equal,
+			expectedOdds: []float64{3, 3.05, 3.1}, expectedStakes: []float64{3.34, 3.33, 3.33},
+			expectedAvgOdd: 3.04995, expectedPL: 0.1665,
+		},
+		"ladder bet 14": {
+			betType: betting.BetType_Back, odd: 3, stake: 10,
+			options:      betting.LadderOptions{Ticks: 1, Distribution: betting.LadderDistribution_Equal},
+			expectedOdds: []float64{3}, expectedStakes: []float64{10},
+			expectedAvgOdd: 3, expectedPL: 0,
+		},
+		"ladder bet 15": {betType: betting.BetType_Back, odd: 3, stake: 30,
+			options: betting.LadderOptions{Ticks: 3, Distribution: betting.LadderDistribution_Equal,
+				Currency: betting.Currency{Code: "GBP", MinStake: -1}}, expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			ladder, err := betting.LadderBet(test.betType, test.odd, test.stake, test.options)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			require.Len(t, ladder.Bets, len(test.expectedOdds), "bets length")
+			for i, bet := range ladder.Bets {
+				assert.Equal(t, test.betType, bet.Type, "bet type field")
+				assert.InDelta(t, test.expectedOdds[i], bet.Odd, float64EqualityThreshold, "odd field")
+				assert.InDelta(t, test.expectedStakes[i], bet.Amount, float64EqualityThreshold, "amount field")
+			}
+
+			assert.InDelta(t, test.expectedAvgOdd, ladder.AvgOdd, float64EqualityThreshold, "average odd field")
+			assert.InDelta(t, test.expectedPL, ladder.GreenBookPL, float64EqualityThreshold, "green book P&L field")
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package betting
+
+import (
+	"fmt"
+	"math"
+)
+
+// ResolveLine returns the outcome of a bet on the given side and line for the final score.
+// Quarter lines, e.g. -0.75, are settled as two bets with half of the stake each on the nearest
+// lines, e.g. -0.5 and -1.
+func ResolveLine(side LineSide, line float64, score Score) (LineOutcome, error) {
+	handicap, err := lineHandicap(side, line)
+	if err != nil {
+		return 0, err
+	}
+
+	if score.Home < 0 || score.Away < 0 {
+		return 0, fmt.Errorf("score cannot be negative")
+	}
+
+	var raw int
+	switch side {
+	case LineSide_Home:
+		raw = score.Home - score.Away
+	case LineSide_Away:
+		raw = score.Away - score.Home
+	case LineSide_Over:
+		raw = score.Home + score.Away
+	case LineSide_Under:
+		raw = -(score.Home + score.Away)
+	}
+
+	return lineOutcome(raw, handicap), nil
+}
+
+// LinePL returns the P&L of bets across Asian handicap and over/under lines for the final score.
+func LinePL(bets []LineBet, score Score) (float64, error) {
+	pl := 0.0
+
+	for _, lineBet := range bets {
+		if lineBet.Bet.Type != BetType_Back && lineBet.Bet.Type != BetType_Lay {
+			return 0, fmt.Errorf("unknown bet type")
+		}
+
+		if lineBet.Bet.Amount == 0 {
+			continue
+		}
+
+		if err := checkBetOdd(lineBet.Bet); err != nil {
+			return 0, err
+		}
+
+		outcome, err := ResolveLine(lineBet.Side, lineBet.Line, score)
+		if err != nil {
+			return 0, err
+		}
+
+		bet := NewBet(lineBet.Bet.Type, lineBet.Bet.Odd, lineBet.Bet.Amount)
+		pl += outcomePL(outcome, bet.WinPL, bet.LosePL)
+	}
+
+	return pl, nil
+}
+
+// GreenBookLine computes what bet to make in order to greenbook an Asian handicap or over/under
+// selection, along with the P&L for each outcome possible with its line.
+// After greenbooking, full wins and full losses have the same P&L, half wins and half losses have
+// half of it, and pushes have no P&L.
+func GreenBookLine(selection LineSelection) (hedge LineHedge, err error) {
+	handicap, err := lineHandicap(selection.Side, selection.Line)
+	if err != nil {
+		return hedge, err
+	}
+
+	bet, err := GreenBookSelection(Selection{
+		Bets:           selection.Bets,
+		CurrentBackOdd: selection.CurrentBackOdd,
+		CurrentLayOdd:  selection.CurrentLayOdd,
+	})
+	if err != nil {
+		return hedge, err
+	}
+
+	hedge.Bet = bet
+	hedge.OutcomesPL = map[LineOutcome]float64{}
+
+	// The line changes outcome somewhere around the handicap, so a few goals either side cover all outcomes
+	pivot := int(math.Floor(-handicap))
+	for raw := pivot - 2; raw <= pivot+2; raw++ {
+		outcome := lineOutcome(raw, handicap)
+		hedge.OutcomesPL[outcome] = outcomePL(outcome, bet.WinPL, bet.LosePL)
+	}
+
+	return hedge, nil
+}
+
+// lineHandicap returns the handicap to add to the goals difference, or goals total, such that a
+// positive result wins, zero pushes and a negative result loses.
+func lineHandicap(side LineSide, line float64) (float64, error) {
+	if line*4 != math.Trunc(line*4) || math.IsInf(line, 0) {
+		return 0, fmt.Errorf("line [%f] must be a multiple of 0.25", line)
+	}
+
+	switch side {
+	case LineSide_Home, LineSide_Away:
+		return line, nil
+	case LineSide_Over, LineSide_Under:
+		if line < 0 {
+			return 0, fmt.Errorf("line [%f] cannot be negative for over/under bets", line)
+		}
+
+		if side == LineSide_Over {
+			return -line, nil
+		}
+		return line, nil
+	default:
+		return 0, fmt.Errorf("unknown line side")
+	}
+}
+
+// lineOutcome returns the outcome of a bet given the goals difference, or goals total, already
+// signed for the side of the bet.
+func lineOutcome(raw int, handicap float64) LineOutcome {
+	sign := func(margin float64) int {
+		if margin > 0 {
+			return 1
+		} else if margin < 0 {
+			return -1
+		}
+		return 0
+	}
+
+	// Quarter lines are split in two halves
+	quarter := math.Mod(math.Abs(handicap)*4, 2) == 1
+
+	var result int
+	if quarter {
+		result = sign(float64(raw)+handicap-0.25) + sign(float64(raw)+handicap+0.25)
+	} else {
+		result = 2 * sign(float64(raw)+handicap)
+	}
+
+	switch result {
+	case 2:
+		return LineOutcome_Win
+	case 1:
+		return LineOutcome_HalfWin
+	case -1:
+		return LineOutcome_HalfLose
+	case -2:
+		return LineOutcome_Lose
+	default:
+		return LineOutcome_Push
+	}
+}
+
+// outcomePL returns the P&L for an outcome given the P&L if the bet wins or loses in full.
+func outcomePL(outcome LineOutcome, winPL float64, losePL float64) float64 {
+	switch outcome {
+	case LineOutcome_Win:
+		return winPL
+	case LineOutcome_HalfWin:
+		return winPL / 2
+	case LineOutcome_HalfLose:
+		return losePL / 2
+	case LineOutcome_Lose:
+		return losePL
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,173 @@
+package betting_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveLine(t *testing.T) {
+	tests := map[string]struct {
+		side            betting.LineSide
+		line            float64
+		score           betting.Score
+		expectedOutcome betting.LineOutcome
+		expectedErr     bool
+	}{
+		"resolve line 1":  {side: betting.LineSide_Home, line: 0.1, score: betting.Score{Home: 1}, expectedErr: true},
+		"resolve line 2":  {side: betting.LineSide_Over, line: -0.5, score: betting.Score{Home: 1}, expectedErr: true},
+		"resolve line 3":  {line: 0.5, score: betting.Score{Home: 1}, expectedErr: true},
+		"resolve line 4":  {side: betting.LineSide_Home, line: 0.5, score: betting.Score{Home: -1}, expectedErr: true},
+		"resolve line 5":  {side: betting.LineSide_Home, line: -0.5, score: betting.Score{Home: 1}, expectedOutcome: betting.LineOutcome_Win},
+		"resolve line 6":  {side: betting.LineSide_Home, line: -0.5, score: betting.Score{Home: 1, Away: 1}, expectedOutcome: betting.LineOutcome_Lose},
+		"resolve line 7":  {side: betting.LineSide_Home, line: -1, score: betting.Score{Home: 2, Away: 1}, expectedOutcome: betting.LineOutcome_Push},
+		"resolve line 8":  {side: betting.LineSide_Home, line: -0.75, score: betting.Score{Home: 2, Away: 1}, expectedOutcome: betting.LineOutcome_HalfWin},
+		"resolve line 9":  {side: betting.LineSide_Home, line: -0.75, score: betting.Score{Home: 3, Away: 1}, expectedOutcome: betting.LineOutcome_Win},
+		"resolve line 10": {side: betting.LineSide_Home, line: -0.25, score: betting.Score{Home: 1, Away: 1}, expectedOutcome: betting.LineOutcome_HalfLose},
+		"resolve line 11": {side: betting.LineSide_Away, line: 0.25, score: betting.Score{Home: 1, Away: 1}, expectedOutcome: betting.LineOutcome_HalfWin},
+		"resolve line 12": {side: betting.LineSide_Away, line: 1.5, score: betting.Score{Home: 2, Away: 1}, expectedOutcome: betting.LineOutcome_Win},
+		"resolve line 13": {side: betting.LineSide_Over, line: 2.5, score: betting.Score{Home: 2, Away: 1}, expectedOutcome: betting.LineOutcome_Win},
+		"resolve line 14": {side: betting.LineSide_Over, line: 2.25, score: betting.Score{Home: 1, Away: 1}, expectedOutcome: betting.LineOutcome_HalfLose},
+		"resolve line 15": {side: betting.LineSide_Over, line: 2.75, score: betting.Score{Home: 2, Away: 1}, expectedOutcome: betting.LineOutcome_HalfWin},
+		"resolve line 16": {side: betting.LineSide_Under, line: 2.75, score: betting.Score{Home: 2, Away: 1}, expectedOutcome: betting.LineOutcome_HalfLose},
+		"resolve line 17": {side: betting.LineSide_Under, line: 3, score: betting.Score{Home: 2, Away: 1}, expectedOutcome: betting.LineOutcome_Push},
+		"resolve line 18": {side: betting.LineSide_Under, line: 1.5, score: betting.Score{Home: 2, Away: 1}, expectedOutcome: betting.LineOutcome_Lose},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			outcome, err := betting.ResolveLine(test.side, test.line, test.score)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.Equal(t, test.expectedOutcome, outcome)
+		})
+	}
+}
+
+func TestLinePL(t *testing.T) {
+	tests := map[string]struct {
+		bets        []betting.LineBet
+		score       betting.Score
+		expectedPL  float64
+		expectedErr bool
+	}{
+		"line P&L 1": {
+			bets:        []betting.LineBet{{Bet: betting.Bet{Type: betting.BetType_Back, Odd: 2.01, Amount: 10}, Side: betting.LineSide_Home, Line: -0.5}},
+			expectedErr: true,
+		},
+		"line P&L 2": {
+			bets:        []betting.LineBet{{Bet: betting.Bet{Odd: 2, Amount: 10}, Side: betting.LineSide_Home, Line: -0.5}},
+			expectedErr: true,
+		},
+		"line P&L 3": {
+			bets: []betting.LineBet{
+				{Bet: betting.Bet{Type: betting.BetType_Back, Odd: 2, Amount: 10}, Side: betting.LineSide_Home, Line: -0.75},
+				{Bet: betting.Bet{Type: betting.BetType_Lay, Odd: 1.8, Amount: 10}, Side: betting.LineSide_Over, Line: 2.25},
+				{Bet: betting.Bet{Type: betting.BetType_Back, Odd: 3, Amount: 5}, Side: betting.LineSide_Away, Line: 1},
+			},
+			score:      betting.Score{Home: 1, Away: 0},
+			expectedPL: 15,
+		},
+		"line P&L 4": {
+			bets: []betting.LineBet{
+				{Bet: betting.Bet{Type: betting.BetType_Back, Odd: 2, Amount: 10}, Side: betting.LineSide_Home, Line: -0.75},
+				{Bet: betting.Bet{Type: betting.BetType_Lay, Odd: 1.8, Amount: 10}, Side: betting.LineSide_Over, Line: 2.25},
+			},
+			score:      betting.Score{Home: 1, Away: 1},
+			expectedPL: -5,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			pl, err := betting.LinePL(test.bets, test.score)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.InDelta(t, test.expectedPL, pl, float64EqualityThreshold)
+		})
+	}
+}
+
+func TestGreenBookLine(t *testing.T) {
+	tests := map[string]struct {
+		selection          betting.LineSelection
+		expectedBet        betting.Bet
+		expectedOutcomesPL map[betting.LineOutcome]float64
+		expectedErr        bool
+	}{
+		"greenbook line 1": {
+			selection: betting.LineSelection{
+				Side: betting.LineSide_Home, Line: -0.6,
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 2, Amount: 10}},
+				CurrentBackOdd: 1.8, CurrentLayOdd: 1.81,
+			},
+			expectedErr: true,
+		},
+		"greenbook line 2": {
+			selection: betting.LineSelection{
+				Side: betting.LineSide_Home, Line: -0.75,
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 2, Amount: 10}},
+				CurrentBackOdd: 1.58, CurrentLayOdd: 1.6,
+			},
+			expectedBet: betting.Bet{Type: betting.BetType_Lay, Odd: 1.6, Amount: 12.5},
+			expectedOutcomesPL: map[betting.LineOutcome]float64{
+				betting.LineOutcome_Win:     2.5,
+				betting.LineOutcome_HalfWin: 1.25,
+				betting.LineOutcome_Lose:    2.5,
+			},
+		},
+		"greenbook line 3": {
+			selection: betting.LineSelection{
+				Side: betting.LineSide_Over, Line: 2,
+				Bets:           []betting.Bet{{Type: betting.BetType_Lay, Odd: 2, Amount: 10}},
+				CurrentBackOdd: 2.5, CurrentLayOdd: 2.52,
+			},
+			expectedBet: betting.Bet{Type: betting.BetType_Back, Odd: 2.5, Amount: 8},
+			expectedOutcomesPL: map[betting.LineOutcome]float64{
+				betting.LineOutcome_Win:  2,
+				betting.LineOutcome_Push: 0,
+				betting.LineOutcome_Lose: 2,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			hedge, err := betting.GreenBookLine(test.selection)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.Equal(t, test.expectedBet.Type, hedge.Bet.Type, "bet type field")
+			assert.Equal(t, test.expectedBet.Odd, hedge.Bet.Odd, "bet odd field")
+			assert.InDelta(t, test.expectedBet.Amount, hedge.Bet.Amount, amountEqualityThreshold, "bet amount field")
+			require.Len(t, hedge.OutcomesPL, len(test.expectedOutcomesPL))
+
+			for outcome, pl := range test.expectedOutcomesPL {
+				assert.InDelta(t, pl, hedge.OutcomesPL[outcome], amountEqualityThreshold, "P&L for outcome %s", outcome)
+			}
+		})
+	}
+}
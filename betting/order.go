@@ -0,0 +1,293 @@
+package betting
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gustavooferreira/bfutils/internal"
+)
+
+// NewOrder returns a new order placed in the market, with nothing matched yet.
+func NewOrder(betID string, betType BetType, odd float64, size float64, persistenceType PersistenceType) (*Order, error) {
+	if betType != BetType_Back && betType != BetType_Lay {
+		return nil, fmt.Errorf("unknown bet type")
+	}
+
+	if _, err := oddIndex(odd); err != nil {
+		return nil, err
+	}
+
+	if size <= 0 {
+		return nil, fmt.Errorf("size [%f] must be positive", size)
+	}
+
+	if persistenceType != PersistenceType_Lapse && persistenceType != PersistenceType_Persist &&
+		persistenceType != PersistenceType_MarketOnClose {
+		return nil, fmt.Errorf("unknown persistence type")
+	}
+
+	return &Order{
+		BetID:           betID,
+		Type:            betType,
+		Odd:             odd,
+		PersistenceType: persistenceType,
+		Status:          OrderStatus_Placed,
+		SizeRequested:   size,
+	}, nil
+}
+
+// SizeRemaining returns the size still waiting to be matched.
+func (o *Order) SizeRemaining() float64 {
+	remaining := o.SizeRequested - o.SizeMatched - o.SizeCancelled - o.SizeLapsed
+	if internal.EqualWithTolerance(0.0, remaining) {
+		return 0
+	}
+	return remaining
+}
+
+// IsOpen returns true if the order still has size waiting to be matched.
+func (o *Order) IsOpen() bool {
+	return o.Status == OrderStatus_Placed || o.Status == OrderStatus_PartiallyMatched
+}
+
+// Match records a fill of the order.
+// odd must be the odd requested or a better one, i.e., higher for back orders and lower for lay orders.
+func (o *Order) Match(odd float64, size float64) error {
+	if !o.IsOpen() {
+		return fmt.Errorf("order [%s] is not open", o.BetID)
+	}
+
+	if _, err := oddIndex(odd); err != nil {
+		return err
+	}
+
+	if (o.Type == BetType_Back && odd < o.Odd) || (o.Type == BetType_Lay && odd > o.Odd) {
+		return fmt.Errorf("odd matched [%f] is worse than the odd requested [%f]", odd, o.Odd)
+	}
+
+	if err := o.checkSize(size); err != nil {
+		return err
+	}
+
+	o.AvgPriceMatched = (o.AvgPriceMatched*o.SizeMatched + odd*size) / (o.SizeMatched + size)
+	o.SizeMatched += size
+	o.Matches = append(o.Matches, PriceSize{Odd: odd, Size: size})
+
+	o.Status = OrderStatus_PartiallyMatched
+	if o.SizeRemaining() == 0 {
+		o.Status = OrderStatus_Matched
+	}
+
+	return nil
+}
+
+// Cancel cancels part of the unmatched size of the order.
+// A size of 0 cancels all the unmatched size.
+func (o *Order) Cancel(size float64) error {
+	if !o.IsOpen() {
+		return fmt.Errorf("order [%s] is not open", o.BetID)
+	}
+
+	if size == 0 {
+		size = o.SizeRemaining()
+	}
+
+	if err := o.checkSize(size); err != nil {
+		return err
+	}
+
+	o.SizeCancelled += size
+	if o.SizeRemaining() == 0 {
+		o.Status = OrderStatus_Cancelled
+	}
+
+	return nil
+}
+
+// Lapse lapses all the unmatched size of the order.
+func (o *Order) Lapse() error {
+	if !o.IsOpen() {
+		return fmt.Errorf("order [%s] is not open", o.BetID)
+	}
+
+	o.SizeLapsed += o.SizeRemaining()
+	o.Status = OrderStatus_Lapsed
+
+	return nil
+}
+
+// TurnInPlay applies the persistence type of the order when the market turns in-play.
+// Orders with PersistenceType_Lapse are lapsed and orders with PersistenceType_Persist are kept open.
+// For orders with PersistenceType_MarketOnClose, the unmatched size leaves the order as lapsed and
+// is returned as a starting price bet.
+func (o *Order) TurnInPlay() (bspBet BSPBet, converted bool, err error) {
+	if !o.IsOpen() {
+		return bspBet, false, nil
+	}
+
+	switch o.PersistenceType {
+	case PersistenceType_Lapse:
+		return bspBet, false, o.Lapse()
+	case PersistenceType_MarketOnClose:
+		bspBet = BSPBet{Type: o.Type, OrderType: OrderType_MarketOnClose, Amount: o.SizeRemaining()}
+		if o.Type == BetType_Lay {
+			bspBet.Amount *= o.Odd - 1
+		}
+
+		if err := o.Lapse(); err != nil {
+			return BSPBet{}, false, err
+		}
+		return bspBet, true, nil
+	default:
+		return bspBet, false, nil
+	}
+}
+
+// MatchedBets returns one bet for every fill of the order.
+func (o *Order) MatchedBets() []Bet {
+	bets := make([]Bet, 0, len(o.Matches))
+	for _, match := range o.Matches {
+		bets = append(bets, NewBet(o.Type, match.Odd, match.Size))
+	}
+	return bets
+}
+
+// checkSize returns an error if size is not positive or is greater than the size remaining.
+func (o *Order) checkSize(size float64) error {
+	if size <= 0 {
+		return fmt.Errorf("size [%f] must be positive", size)
+	}
+
+	if remaining := o.SizeRemaining(); size > remaining && !internal.EqualWithTolerance(size, remaining) {
+		return fmt.Errorf("size [%f] is greater than the size remaining [%f]", size, remaining)
+	}
+
+	return nil
+}
+
+// MatchedBets returns the bets matched by all orders, which can be used to compute the matched-only
+// view of a selection, e.g. with GreenBookSelection.
+func MatchedBets(orders []Order) []Bet {
+	bets := []Bet{}
+	for i := range orders {
+		bets = append(bets, orders[i].MatchedBets()...)
+	}
+	return bets
+}
+
+// ComputeOrdersPL returns the P&L of the orders in a selection considering the matched size only,
+// all the unmatched size getting matched at the odd requested, and the worst case in between.
+func ComputeOrdersPL(orders []Order) (pl OrdersPL, err error) {
+	for i := range orders {
+		order := &orders[i]
+
+		if order.Type != BetType_Back && order.Type != BetType_Lay {
+			return OrdersPL{}, fmt.Errorf("unknown bet type")
+		}
+
+		if _, err := oddIndex(order.Odd); err != nil {
+			return OrdersPL{}, err
+		}
+
+		for _, bet := range order.MatchedBets() {
+			pl.MatchedWinPL += bet.WinPL
+			pl.MatchedLosePL += bet.LosePL
+		}
+
+		if !order.IsOpen() {
+			continue
+		}
+
+		unmatched := NewBet(order.Type, order.Odd, order.SizeRemaining())
+		pl.AllMatchedWinPL += unmatched.WinPL
+		pl.AllMatchedLosePL += unmatched.LosePL
+		pl.WorstWinPL += math.Min(0, unmatched.WinPL)
+		pl.WorstLosePL += math.Min(0, unmatched.LosePL)
+	}
+
+	pl.AllMatchedWinPL += pl.MatchedWinPL
+	pl.AllMatchedLosePL += pl.MatchedLosePL
+	pl.WorstWinPL += pl.MatchedWinPL
+	pl.WorstLosePL += pl.MatchedLosePL
+
+	return pl, nil
+}
+
+// GreenBookSelectionWithOrders computes the actions to take in order to greenbook a selection,
+// taking into account the orders already in the market, so that the selection is not over-hedged.
+// selection.Bets must only hold bets that are not part of the orders, as the size matched by the
+// orders is taken from the orders themselves.
+// Open orders on the opposite side of the hedge are cancelled. Open orders on the same side of the
+// hedge are replaced at the current odd, as used by GreenBookSelection, and kept as long as they
+// don't over-hedge the selection, starting with the ones already at the current odd. Orders that
+// would over-hedge are reduced or cancelled, and a new order is placed for any size still missing.
+// It returns the actions to take and the bet that greenbooks the selection, as if all orders kept
+// were a single bet. If the selection is already edged, or nothing has been matched yet, all open
+// orders are cancelled and a zero bet is returned, unless there is nothing to cancel, in which case
+// AlreadyEdgedError, or an error for a selection without bets, is returned.
+func GreenBookSelectionWithOrders(selection Selection, orders []Order) (actions []Action, hedge Bet, err error) {
+	for i := range orders {
+		if orders[i].Type != BetType_Back && orders[i].Type != BetType_Lay {
+			return nil, hedge, fmt.Errorf("unknown bet type")
+		}
+
+		if _, err := oddIndex(orders[i].Odd); err != nil {
+			return nil, hedge, err
+		}
+	}
+
+	bets := append(append([]Bet{}, selection.Bets...), MatchedBets(orders)...)
+	selection.Bets = bets
+
+	if len(bets) == 0 {
+		// Nothing matched yet, so there is nothing to hedge and all open orders are cancelled
+		err = fmt.Errorf("no bets in this selection")
+	} else if hedge, err = GreenBookSelection(selection); err != nil {
+		if _, ok := err.(*AlreadyEdgedError); !ok {
+			return nil, hedge, err
+		}
+		hedge = Bet{}
+	}
+
+	actions = []Action{}
+	missing := hedge.Amount
+
+	// Orders already at the current odd come first, as they keep their place in the queue
+	open := []*Order{}
+	for _, atCurrentOdd := range []bool{true, false} {
+		for i := range orders {
+			order := &orders[i]
+			if order.IsOpen() && order.SizeRemaining() > 0 && (order.Odd == hedge.Odd) == atCurrentOdd {
+				open = append(open, order)
+			}
+		}
+	}
+
+	for _, order := range open {
+		remaining := order.SizeRemaining()
+
+		if order.Type != hedge.Type || missing <= 0 || internal.EqualWithTolerance(0.0, missing) {
+			actions = append(actions, Action{Type: ActionType_Cancel, BetID: order.BetID, BetType: order.Type, Size: remaining})
+			continue
+		}
+
+		size := math.Min(remaining, missing)
+		missing -= size
+
+		if order.Odd != hedge.Odd {
+			actions = append(actions, Action{Type: ActionType_Replace, BetID: order.BetID, BetType: order.Type, Odd: hedge.Odd, Size: size})
+		} else if size < remaining && !internal.EqualWithTolerance(size, remaining) {
+			actions = append(actions, Action{Type: ActionType_Cancel, BetID: order.BetID, BetType: order.Type, Size: remaining - size})
+		}
+	}
+
+	if missing > 0 && !internal.EqualWithTolerance(0.0, missing) {
+		actions = append(actions, Action{Type: ActionType_Place, BetType: hedge.Type, Odd: hedge.Odd, Size: missing})
+	}
+
+	if err != nil && len(actions) == 0 {
+		return actions, hedge, err
+	}
+
+	return actions, hedge, nil
+}
@@ -0,0 +1,414 @@
+package betting_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrder(t *testing.T) {
+	tests := map[string]struct {
+		betType         betting.BetType
+		odd             float64
+		size            float64
+		persistenceType betting.PersistenceType
+		expectedErr     bool
+	}{
+		"new order 1": {odd: 3, size: 10, persistenceType: betting.PersistenceType_Lapse, expectedErr: true},
+		"new order 2": {betType: betting.BetType_Back, odd: 3.01, size: 10, persistenceType: betting.PersistenceType_Lapse, expectedErr: true},
+		"new order 3": {betType: betting.BetType_Back, odd: 3, size: 0, persistenceType: betting.PersistenceType_Lapse, expectedErr: true},
+		"new order 4": {betType: betting.BetType_Back, odd: 3, size: 10, expectedErr: true},
+		"new order 5": {betType: betting.BetType_Lay, odd: 3, size: 10, persistenceType: betting.PersistenceType_Persist},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			order, err := betting.NewOrder("1", test.betType, test.odd, test.size, test.persistenceType)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assert.Equal(t, betting.OrderStatus(betting.OrderStatus_Placed), order.Status, "status field")
+			assert.Equal(t, test.size, order.SizeRemaining(), "size remaining")
+		})
+	}
+}
+
+func TestOrderTransitions(t *testing.T) {
+	tests := map[string]struct {
+		apply                   func(o *betting.Order) error
+		expectedStatus          betting.OrderStatus
+		expectedSizeMatched     float64
+		expectedSizeRemaining   float64
+		expectedSizeCancelled   float64
+		expectedSizeLapsed      float64
+		expectedAvgPriceMatched float64
+		expectedErr             bool
+	}{
+		"order transitions 1": {
+			apply:                   func(o *betting.Order) error { return o.Match(3, 4) },
+			expectedStatus:          betting.OrderStatus_PartiallyMatched,
+			expectedSizeMatched:     4,
+			expectedSizeRemaining:   6,
+			expectedAvgPriceMatched: 3,
+		},
+		"order transitions 2": {
+			apply: func(o *betting.Order) error {
+				if err := o.Match(3, 4); err != nil {
+					return err
+				}
+				return o.Match(3.1, 6)
+			},
+			expectedStatus:          betting.OrderStatus_Matched,
+			expectedSizeMatched:     10,
+			expectedAvgPriceMatched: 3.06,
+		},
+		"order transitions 3": {
+			apply:       func(o *betting.Order) error { return o.Match(2.98, 4) },
+			expectedErr: true,
+		},
+		"order transitions 4": {
+			apply:       func(o *betting.Order) error { return o.Match(3, 11) },
+			expectedErr: true,
+		},
+		"order transitions 5": {
+			apply:       func(o *betting.Order) error { return o.Match(3.01, 4) },
+			expectedErr: true,
+		},
+		"order transitions 6": {
+			apply: func(o *betting.Order) error {
+				if err := o.Match(3, 4); err != nil {
+					return err
+				}
+				return o.Cancel(0)
+			},
+			expectedStatus:          betting.OrderStatus_Cancelled,
+			expectedSizeMatched:     4,
+			expectedSizeCancelled:   6,
+			expectedAvgPriceMatched: 3,
+		},
+		"order transitions 7": {
+			apply:                 func(o *betting.Order) error { return o.Cancel(3) },
+			expectedStatus:        betting.OrderStatus_Placed,
+			expectedSizeRemaining: 7,
+			expectedSizeCancelled: 3,
+		},
+		"order transitions 8": {
+			apply: func(o *betting.Order) error {
+				if err := o.Cancel(0); err != nil {
+					return err
+				}
+				return o.Match(3, 1)
+			},
+			expectedErr: true,
+		},
+		"order transitions 9": {
+			apply: func(o *betting.Order) error {
+				if err := o.Match(3, 4); err != nil {
+					return err
+				}
+				return o.Lapse()
+			},
+			expectedStatus:          betting.OrderStatus_Lapsed,
+			expectedSizeMatched:     4,
+			expectedSizeLapsed:      6,
+			expectedAvgPriceMatched: 3,
+		},
+		"order transitions 10": {
+			apply:       func(o *betting.Order) error { return o.Cancel(-1) },
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			order, err := betting.NewOrder("1", betting.BetType_Back, 3, 10, betting.PersistenceType_Lapse)
+			require.NoError(t, err)
+
+			var errBool bool
+			var errMsg string
+			err = test.apply(order)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assert.Equal(t, test.expectedStatus, order.Status, "status field")
+			assert.InDelta(t, test.expectedSizeMatched, order.SizeMatched, float64EqualityThreshold, "size matched field")
+			assert.InDelta(t, test.expectedSizeRemaining, order.SizeRemaining(), float64EqualityThreshold, "size remaining")
+			assert.InDelta(t, test.expectedSizeCancelled, order.SizeCancelled, float64EqualityThreshold, "size cancelled field")
+			assert.InDelta(t, test.expectedSizeLapsed, order.SizeLapsed, float64EqualityThreshold, "size lapsed field")
+			assert.InDelta(t, test.expectedAvgPriceMatched, order.AvgPriceMatched, float64EqualityThreshold, "average price matched field")
+		})
+	}
+}
+
+func TestOrderTurnInPlay(t *testing.T) {
+	tests := map[string]struct {
+		betType           betting.BetType
+		persistenceType   betting.PersistenceType
+		expectedStatus    betting.OrderStatus
+		expectedBSPBet    betting.BSPBet
+		expectedConverted bool
+	}{
+		"turn in-play 1": {
+			betType:         betting.BetType_Back,
+			persistenceType: betting.PersistenceType_Lapse,
+			expectedStatus:  betting.OrderStatus_Lapsed,
+		},
+		"turn in-play 2": {
+			betType:         betting.BetType_Back,
+			persistenceType: betting.PersistenceType_Persist,
+			expectedStatus:  betting.OrderStatus_PartiallyMatched,
+		},
+		"turn in-play 3": {
+			betType:           betting.BetType_Back,
+			persistenceType:   betting.PersistenceType_MarketOnClose,
+			expectedStatus:    betting.OrderStatus_Lapsed,
+			expectedBSPBet:    betting.BSPBet{Type: betting.BetType_Back, OrderType: betting.OrderType_MarketOnClose, Amount: 6},
+			expectedConverted: true,
+		},
+		"turn in-play 4": {
+			betType:           betting.BetType_Lay,
+			persistenceType:   betting.PersistenceType_MarketOnClose,
+			expectedStatus:    betting.OrderStatus_Lapsed,
+			expectedBSPBet:    betting.BSPBet{Type: betting.BetType_Lay, OrderType: betting.OrderType_MarketOnClose, Amount: 12},
+			expectedConverted: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			order, err := betting.NewOrder("1", test.betType, 3, 10, test.persistenceType)
+			require.NoError(t, err)
+			require.NoError(t, order.Match(3, 4))
+
+			bspBet, converted, err := order.TurnInPlay()
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedStatus, order.Status, "status field")
+			assert.Equal(t, test.expectedConverted, converted, "converted field")
+			assert.Equal(t, test.expectedBSPBet.Type, bspBet.Type, "bet type field")
+			assert.Equal(t, test.expectedBSPBet.OrderType, bspBet.OrderType, "order type field")
+			assert.InDelta(t, test.expectedBSPBet.Amount, bspBet.Amount, float64EqualityThreshold, "amount field")
+		})
+	}
+}
+
+func TestComputeOrdersPL(t *testing.T) {
+	back, err := betting.NewOrder("1", betting.BetType_Back, 3, 10, betting.PersistenceType_Lapse)
+	require.NoError(t, err)
+	require.NoError(t, back.Match(3, 4))
+
+	lay, err := betting.NewOrder("2", betting.BetType_Lay, 2, 5, betting.PersistenceType_Persist)
+	require.NoError(t, err)
+
+	cancelled, err := betting.NewOrder("3", betting.BetType_Lay, 2.5, 4, betting.PersistenceType_Lapse)
+	require.NoError(t, err)
+	require.NoError(t, cancelled.Match(2.5, 2))
+	require.NoError(t, cancelled.Cancel(0))
+
+	tests := map[string]struct {
+		orders      []betting.Order
+		expectedPL  betting.OrdersPL
+		expectedErr bool
+	}{
+		"orders P&L 1": {orders: []betting.Order{{Type: betting.BetType_Back, Odd: 3.01}}, expectedErr: true},
+		"orders P&L 2": {orders: []betting.Order{{Odd: 3}}, expectedErr: true},
+		"orders P&L 3": {
+			orders: []betting.Order{*back},
+			expectedPL: betting.OrdersPL{
+				MatchedWinPL: 8, MatchedLosePL: -4,
+				AllMatchedWinPL: 20, AllMatchedLosePL: -10,
+				WorstWinPL: 8, WorstLosePL: -10,
+			},
+		},
+		"orders P&L 4": {
+			orders: []betting.Order{*back, *lay, *cancelled},
+			expectedPL: betting.OrdersPL{
+				MatchedWinPL: 5, MatchedLosePL: -2,
+				AllMatchedWinPL: 12, AllMatchedLosePL: -3,
+				WorstWinPL: 0, WorstLosePL: -8,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			pl, err := betting.ComputeOrdersPL(test.orders)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.InDelta(t, test.expectedPL.MatchedWinPL, pl.MatchedWinPL, float64EqualityThreshold, "matched win P&L field")
+			assert.InDelta(t, test.expectedPL.MatchedLosePL, pl.MatchedLosePL, float64EqualityThreshold, "matched lose P&L field")
+			assert.InDelta(t, test.expectedPL.AllMatchedWinPL, pl.AllMatchedWinPL, float64EqualityThreshold, "all matched win P&L field")
+			assert.InDelta(t, test.expectedPL.AllMatchedLosePL, pl.AllMatchedLosePL, float64EqualityThreshold, "all matched lose P&L field")
+			assert.InDelta(t, test.expectedPL.WorstWinPL, pl.WorstWinPL, float64EqualityThreshold, "worst win P&L field")
+			assert.InDelta(t, test.expectedPL.WorstLosePL, pl.WorstLosePL, float64EqualityThreshold, "worst lose P&L field")
+		})
+	}
+}
+
+func TestGreenBookSelectionWithOrders(t *testing.T) {
+	newOrder := func(betID string, betType betting.BetType, odd float64, size float64, matched float64) betting.Order {
+		order, err := betting.NewOrder(betID, betType, odd, size, betting.PersistenceType_Lapse)
+		require.NoError(t, err)
+		if matched > 0 {
+			require.NoError(t, order.Match(odd, matched))
+		}
+		return *order
+	}
+
+	selection := betting.Selection{
+		Bets:           []betting.Bet{betting.NewBet(betting.BetType_Back, 4, 10)},
+		CurrentBackOdd: 3,
+		CurrentLayOdd:  3.05,
+	}
+
+	tests := map[string]struct {
+		selection       betting.Selection
+		orders          []betting.Order
+		expectedActions []betting.Action
+		expectedHedge   betting.Bet
+		expectedErr     bool
+	}{
+		"green book with orders 1": {
+			selection:   selection,
+			orders:      []betting.Order{{BetID: "1", Type: betting.BetType_Lay, Odd: 3.01}},
+			expectedErr: true,
+		},
+		"green book with orders 2": {
+			selection: selection,
+			expectedActions: []betting.Action{
+				{Type: betting.ActionType_Place, BetType: betting.BetType_Lay, Odd: 3.05, Size: 13.11},
+			},
+			expectedHedge: betting.Bet{Type: betting.BetType_Lay, Odd: 3.05, Amount: 13.11},
+		},
+		"green book with orders 3": {
+			selection: selection,
+			orders: []betting.Order{
+				newOrder("1", betting.BetType_Lay, 3.1, 5, 0),
+				newOrder("2", betting.BetType_Lay, 3.05, 10, 0),
+				newOrder("3", betting.BetType_Back, 3, 5, 0),
+			},
+			expectedActions: []betting.Action{
+				{Type: betting.ActionType_Replace, BetID: "1", BetType: betting.BetType_Lay, Odd: 3.05, Size: 3.11},
+				{Type: betting.ActionType_Cancel, BetID: "3", BetType: betting.BetType_Back, Size: 5},
+			},
+			expectedHedge: betting.Bet{Type: betting.BetType_Lay, Odd: 3.05, Amount: 13.11},
+		},
+		"green book with orders 4": {
+			selection: selection,
+			orders: []betting.Order{
+				newOrder("1", betting.BetType_Lay, 3.05, 20, 0),
+				newOrder("2", betting.BetType_Lay, 3.05, 5, 0),
+			},
+			expectedActions: []betting.Action{
+				{Type: betting.ActionType_Cancel, BetID: "1", BetType: betting.BetType_Lay, Size: 6.89},
+				{Type: betting.ActionType_Cancel, BetID: "2", BetType: betting.BetType_Lay, Size: 5},
+			},
+			expectedHedge: betting.Bet{Type: betting.BetType_Lay, Odd: 3.05, Amount: 13.11},
+		},
+		"green book with orders 5": {
+			selection: betting.Selection{CurrentBackOdd: 3, CurrentLayOdd: 3.05},
+			orders: []betting.Order{
+				newOrder("1", betting.BetType_Back, 4, 10, 10),
+				newOrder("2", betting.BetType_Lay, 3.05, 10, 5),
+			},
+			expectedActions: []betting.Action{
+				{Type: betting.ActionType_Place, BetType: betting.BetType_Lay, Odd: 3.05, Size: 3.11},
+			},
+			expectedHedge: betting.Bet{Type: betting.BetType_Lay, Odd: 3.05, Amount: 8.11},
+		},
+		"green book with orders 6": {
+			selection: betting.Selection{CurrentBackOdd: 3, CurrentLayOdd: 3.05},
+			orders: []betting.Order{
+				newOrder("1", betting.BetType_Back, 3, 10, 10),
+				newOrder("2", betting.BetType_Lay, 3, 10, 10),
+				newOrder("3", betting.BetType_Back, 3.05, 2, 0),
+			},
+			expectedActions: []betting.Action{
+				{Type: betting.ActionType_Cancel, BetID: "3", BetType: betting.BetType_Back, Size: 2},
+			},
+		},
+		"green book with orders 7": {
+			selection: betting.Selection{CurrentBackOdd: 3, CurrentLayOdd: 3.05},
+			orders: []betting.Order{
+				newOrder("1", betting.BetType_Back, 3, 10, 10),
+				newOrder("2", betting.BetType_Lay, 3, 10, 10),
+			},
+			expectedErr: true,
+		},
+		"green book with orders 8": {
+			selection: betting.Selection{CurrentBackOdd: 3, CurrentLayOdd: 3.05},
+			orders: []betting.Order{
+				newOrder("1", betting.BetType_Lay, 3.05, 10, 0),
+				newOrder("2", betting.BetType_Back, 4, 5, 0),
+			},
+			expectedActions: []betting.Action{
+				{Type: betting.ActionType_Cancel, BetID: "1", BetType: betting.BetType_Lay, Size: 10},
+				{Type: betting.ActionType_Cancel, BetID: "2", BetType: betting.BetType_Back, Size: 5},
+			},
+		},
+		"green book with orders 9": {
+			selection:   betting.Selection{CurrentBackOdd: 3, CurrentLayOdd: 3.05},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			actions, hedge, err := betting.GreenBookSelectionWithOrders(test.selection, test.orders)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			require.Len(t, actions, len(test.expectedActions), "actions length")
+			for i, action := range actions {
+				expected := test.expectedActions[i]
+				assert.Equal(t, expected.Type, action.Type, "action type field")
+				assert.Equal(t, expected.BetID, action.BetID, "bet ID field")
+				assert.Equal(t, expected.BetType, action.BetType, "bet type field")
+				assert.InDelta(t, expected.Odd, action.Odd, float64EqualityThreshold, "odd field")
+				assert.InDelta(t, expected.Size, action.Size, amountEqualityThreshold, "size field")
+			}
+
+			assert.Equal(t, test.expectedHedge.Type, hedge.Type, "hedge type field")
+			assert.InDelta(t, test.expectedHedge.Odd, hedge.Odd, float64EqualityThreshold, "hedge odd field")
+			assert.InDelta(t, test.expectedHedge.Amount, hedge.Amount, amountEqualityThreshold, "hedge amount field")
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package betting
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gustavooferreira/bfutils"
+)
+
+// position holds the average odds and amounts of all back and lay bets in a selection.
+type position struct {
+	backAvgOdd float64
+	backAmount float64
+	layAvgOdd  float64
+	layAmount  float64
+}
+
+// newPosition aggregates bets into a position.
+// Bets with a zero amount are ignored.
+func newPosition(bets []Bet) (pos position, err error) {
+	for _, bet := range bets {
+		if bet.Amount == 0 {
+			continue
+		}
+
+		if err := checkBetOdd(bet); err != nil {
+			return pos, err
+		}
+
+		if bet.Type == BetType_Back {
+			pos.backAvgOdd = (pos.backAvgOdd*pos.backAmount + bet.Odd*bet.Amount) / (pos.backAmount + bet.Amount)
+			pos.backAmount += bet.Amount
+		} else if bet.Type == BetType_Lay {
+			pos.layAvgOdd = (pos.layAvgOdd*pos.layAmount + bet.Odd*bet.Amount) / (pos.layAmount + bet.Amount)
+			pos.layAmount += bet.Amount
+		} else {
+			return pos, fmt.Errorf("unknown bet type")
+		}
+	}
+
+	return pos, nil
+}
+
+// exposure returns the amount to be hedged, expressed as stake times odd.
+// A positive value means the position needs a lay bet to be hedged, a negative value means it
+// needs a back bet.
+func (p position) exposure() float64 {
+	return p.backAvgOdd*p.backAmount - p.layAvgOdd*p.layAmount
+}
+
+// losePL returns the profit or loss in case the selection loses.
+func (p position) losePL() float64 {
+	return p.layAmount - p.backAmount
+}
+
+// selectionBets returns all bets in the selection, including starting price bets projected at
+// the near price, or at the far price if the near price is not known.
+func selectionBets(selection Selection) ([]Bet, error) {
+	if len(selection.BSPBets) == 0 {
+		return selection.Bets, nil
+	}
+
+	sp := selection.NearPrice
+	if sp == 0 {
+		sp = selection.FarPrice
+	}
+
+	if sp == 0 {
+		return nil, fmt.Errorf("no projected starting price for starting price bets")
+	}
+
+	projected, err := ProjectBSPBets(selection.BSPBets, sp)
+	if err != nil {
+		return nil, err
+	}
+
+	bets := make([]Bet, 0, len(selection.Bets)+len(projected))
+	bets = append(bets, selection.Bets...)
+	return append(bets, projected...), nil
+}
+
+// oddIndex returns the index of the odd in the ladder.
+// An error is returned if the odd does not exist in the ladder.
+func oddIndex(odd float64) (index int, err error) {
+	match, index, err := bfutils.FindOdd(odd)
+	if err != nil {
+		return 0, err
+	}
+	if !match {
+		return 0, fmt.Errorf("odd provided [%f] does not exist in the ladder", odd)
+	}
+	return index, nil
+}
+
+// checkBetOdd returns an error if the odd of the bet is not valid.
+// Odds reduced because of a non-runner, or matched at the starting price, only need to be within
+// the trading range.
+func checkBetOdd(bet Bet) error {
+	if bet.ReductionFactor > 0 || bet.PriceReduced || bet.OrderType != OrderType_Limit {
+		if withinBoundary := bfutils.IsOddWithinBoundaries(bet.Odd); !withinBoundary {
+			return fmt.Errorf("odd provided [%f] is outside of trading range", bet.Odd)
+		}
+		return nil
+	}
+
+	_, err := oddIndex(bet.Odd)
+	return err
+}
+
+// CheckCommission returns an error if the commission is not a valid decimal percentage,
+// i.e., it must be at least 0 and below 1, meaning a 5% commission is == 0.05.
+// It's the validation used by every function in this package that takes a commission.
+func CheckCommission(commission float64) error {
+	if commission < 0 || commission >= 1 {
+		return fmt.Errorf("commission [%f] must be between 0 and 1", commission)
+	}
+	return nil
+}
+
+// checkProbability returns an error if the probability is not between 0 and 1 (exclusive).
+func checkProbability(prob float64) error {
+	if prob <= 0 || prob >= 1 {
+		return fmt.Errorf("probability [%f] must be between 0 and 1", prob)
+	}
+	return nil
+}
+
+// CheckCurrency returns an error if the stake rules of the currency are not valid,
+// i.e., the minimum stake cannot be negative. A zero minimum stake means any stake is accepted.
+// It's the validation used by every function in this package that takes a currency.
+func CheckCurrency(currency Currency) error {
+	if currency.MinStake < 0 {
+		return fmt.Errorf("minimum stake [%f] cannot be negative", currency.MinStake)
+	}
+	return nil
+}
+
+// RoundStake returns the stake rounded down to the penny, so that it never exceeds the amount given.
+// A zero stake is returned if the rounded stake is below the minimum stake of the currency,
+// meaning the bet should not be placed.
+// The currency is assumed to be valid, see CheckCurrency.
+func (c Currency) RoundStake(stake float64) float64 {
+	stake = math.Floor(stake*100+1e-6) / 100
+	if stake < c.MinStake {
+		return 0
+	}
+	return stake
+}
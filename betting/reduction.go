@@ -0,0 +1,134 @@
+package betting
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gustavooferreira/bfutils"
+)
+
+// ApplyReductionFactor returns the selections of a market after a runner is removed.
+// Bets on the removed runner are void and are dropped. The odds of the bets on all other runners
+// are reduced by the reduction factor of the removed runner, never going below 1.01, and their
+// P&L is recomputed.
+// reductionFactor is a representation in decimal, meaning a 25% reduction factor is == 0.25.
+// Only bets matched before the runner was removed should be provided, as bets matched afterwards
+// are not affected.
+func ApplyReductionFactor(selections []Selection, removedID int64, reductionFactor float64) ([]Selection, error) {
+	if reductionFactor < 0 || reductionFactor >= 1 {
+		return nil, fmt.Errorf("reduction factor [%f] must be between 0 and 1", reductionFactor)
+	}
+
+	found := false
+	for _, selection := range selections {
+		if selection.ID == removedID {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("selection [%d] not found", removedID)
+	}
+
+	result := make([]Selection, len(selections))
+
+	for i, selection := range selections {
+		result[i] = selection
+
+		if selection.ID == removedID {
+			result[i].Bets = nil
+			continue
+		}
+
+		if selection.Bets == nil {
+			continue
+		}
+
+		result[i].Bets = make([]Bet, len(selection.Bets))
+		for j, bet := range selection.Bets {
+			reduced, err := ReduceBet(bet, reductionFactor)
+			if err != nil {
+				return nil, err
+			}
+			result[i].Bets[j] = reduced
+		}
+	}
+
+	return result, nil
+}
+
+// ReduceBet returns the bet with its odd reduced by the reduction factor, never going below 1.01,
+// and its P&L recomputed. All other fields, e.g. OrderType, are kept.
+// reductionFactor is a representation in decimal, meaning a 25% reduction factor is == 0.25.
+func ReduceBet(bet Bet, reductionFactor float64) (Bet, error) {
+	if reductionFactor < 0 || reductionFactor >= 1 {
+		return bet, fmt.Errorf("reduction factor [%f] must be between 0 and 1", reductionFactor)
+	}
+
+	if bet.Type != BetType_Back && bet.Type != BetType_Lay {
+		return bet, fmt.Errorf("unknown bet type")
+	}
+
+	if err := checkBetOdd(bet); err != nil {
+		return bet, err
+	}
+
+	if reductionFactor == 0 {
+		return bet, nil
+	}
+
+	odd := math.Max(bet.Odd*(1-reductionFactor), bfutils.Odds[0])
+
+	pl := NewBet(bet.Type, odd, bet.Amount)
+
+	reduced := bet
+	reduced.Odd = odd
+	reduced.WinPL = pl.WinPL
+	reduced.LosePL = pl.LosePL
+	reduced.ReductionFactor = 1 - (1-bet.ReductionFactor)*(1-reductionFactor)
+
+	return reduced, nil
+}
+
+// DeadHeatFactor returns the fraction of the stake paid at full odds for a runner that dead heats.
+// places is the number of places paid by the market, e.g. 1 for a win market.
+// position is the position at which the runners tied, and tied is the number of runners tied.
+// Example: in a win market, two runners tied for first have a dead heat factor of 0.5.
+func DeadHeatFactor(places int, position int, tied int) (float64, error) {
+	if places < 1 {
+		return 0, fmt.Errorf("number of places must be positive")
+	}
+
+	if position < 1 || position > places {
+		return 0, fmt.Errorf("position [%d] must be between 1 and the number of places [%d]", position, places)
+	}
+
+	if tied < 1 {
+		return 0, fmt.Errorf("number of runners tied must be positive")
+	}
+
+	placesLeft := places - position + 1
+	if placesLeft >= tied {
+		return 1, nil
+	}
+
+	return float64(placesLeft) / float64(tied), nil
+}
+
+// ApplyDeadHeat returns the bet with its P&L recomputed for a runner that dead heated.
+// The part of the stake covered by the dead heat factor is paid at full odds, and the remaining
+// stake is lost. Only the WinPL changes.
+func ApplyDeadHeat(bet Bet, deadHeatFactor float64) (Bet, error) {
+	if deadHeatFactor <= 0 || deadHeatFactor > 1 {
+		return bet, fmt.Errorf("dead heat factor [%f] must be between 0 and 1", deadHeatFactor)
+	}
+
+	pl, err := settleBet(bet, RunnerResult{Status: RunnerStatus_Winner, DeadHeatFactor: deadHeatFactor})
+	if err != nil {
+		return bet, err
+	}
+
+	bet.WinPL = pl
+	return bet, nil
+}
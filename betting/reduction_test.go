@@ -0,0 +1,238 @@
+package betting_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyReductionFactor(t *testing.T) {
+	tests := map[string]struct {
+		selections         []betting.Selection
+		removedID          int64
+		reductionFactor    float64
+		expectedSelections []betting.Selection
+		expectedErr        bool
+	}{
+		"apply reduction factor 1": {
+			selections:      []betting.Selection{{ID: 1}},
+			removedID:       1,
+			reductionFactor: 1,
+			expectedErr:     true,
+		},
+		"apply reduction factor 2": {
+			selections:      []betting.Selection{{ID: 1}},
+			removedID:       2,
+			reductionFactor: 0.25,
+			expectedErr:     true,
+		},
+		"apply reduction factor 3": {
+			selections: []betting.Selection{
+				{ID: 1, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 2, Amount: 10}}},
+				{ID: 2, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 4, Amount: 10}, {Type: betting.BetType_Lay, Odd: 10, Amount: 5}}},
+				{ID: 3},
+			},
+			removedID:       1,
+			reductionFactor: 0.25,
+			expectedSelections: []betting.Selection{
+				{ID: 1},
+				{ID: 2, Bets: []betting.Bet{
+					{Type: betting.BetType_Back, Odd: 3, Amount: 10, WinPL: 20, LosePL: -10, ReductionFactor: 0.25},
+					{Type: betting.BetType_Lay, Odd: 7.5, Amount: 5, WinPL: -32.5, LosePL: 5, ReductionFactor: 0.25},
+				}},
+				{ID: 3},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			selections, err := betting.ApplyReductionFactor(test.selections, test.removedID, test.reductionFactor)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+			require.Len(t, selections, len(test.expectedSelections))
+
+			for i, selection := range selections {
+				assert.Equal(t, test.expectedSelections[i].ID, selection.ID, "selection ID field")
+				require.Len(t, selection.Bets, len(test.expectedSelections[i].Bets))
+
+				for j, bet := range selection.Bets {
+					expected := test.expectedSelections[i].Bets[j]
+					assert.Equal(t, expected.Type, bet.Type, "bet type field")
+					assert.InDelta(t, expected.Odd, bet.Odd, float64EqualityThreshold, "bet odd field")
+					assert.InDelta(t, expected.Amount, bet.Amount, amountEqualityThreshold, "bet amount field")
+					assert.InDelta(t, expected.WinPL, bet.WinPL, amountEqualityThreshold, "Win P&L field")
+					assert.InDelta(t, expected.LosePL, bet.LosePL, amountEqualityThreshold, "Lose P&L field")
+					assert.InDelta(t, expected.ReductionFactor, bet.ReductionFactor, float64EqualityThreshold, "reduction factor field")
+				}
+			}
+		})
+	}
+}
+
+func TestReduceBet(t *testing.T) {
+	tests := map[string]struct {
+		bet             betting.Bet
+		reductionFactor float64
+		expectedBet     betting.Bet
+		expectedErr     bool
+	}{
+		"reduce bet 1": {bet: betting.Bet{Type: betting.BetType_Back, Odd: 10, Amount: 10}, reductionFactor: -0.1, expectedErr: true},
+		"reduce bet 2": {bet: betting.Bet{Type: betting.BetType_Back, Odd: 3.01, Amount: 10}, reductionFactor: 0.1, expectedErr: true},
+		"reduce bet 3": {bet: betting.Bet{Odd: 10, Amount: 10}, reductionFactor: 0.1, expectedErr: true},
+		"reduce bet 4": {
+			bet:             betting.Bet{Type: betting.BetType_Back, Odd: 10, Amount: 10},
+			reductionFactor: 0.25,
+			expectedBet:     betting.Bet{Type: betting.BetType_Back, Odd: 7.5, Amount: 10, WinPL: 65, LosePL: -10, ReductionFactor: 0.25},
+		},
+		"reduce bet 5": {
+			bet:             betting.Bet{Type: betting.BetType_Back, Odd: 7.5, Amount: 10, ReductionFactor: 0.25},
+			reductionFactor: 0.2,
+			expectedBet:     betting.Bet{Type: betting.BetType_Back, Odd: 6, Amount: 10, WinPL: 50, LosePL: -10, ReductionFactor: 0.4},
+		},
+		"reduce bet 6": {
+			bet:             betting.Bet{Type: betting.BetType_Lay, Odd: 1.2, Amount: 10},
+			reductionFactor: 0.5,
+			expectedBet:     betting.Bet{Type: betting.BetType_Lay, Odd: 1.01, Amount: 10, WinPL: -0.1, LosePL: 10, ReductionFactor: 0.5},
+		},
+		"reduce bet 7": {
+			bet:         betting.Bet{Type: betting.BetType_Lay, Odd: 4, Amount: 10, WinPL: -30, LosePL: 10},
+			expectedBet: betting.Bet{Type: betting.BetType_Lay, Odd: 4, Amount: 10, WinPL: -30, LosePL: 10},
+		},
+		"reduce bet 8": {
+			bet:             betting.Bet{Type: betting.BetType_Back, Odd: 7.3, Amount: 10, OrderType: betting.OrderType_MarketOnClose},
+			reductionFactor: 0.5,
+			expectedBet: betting.Bet{Type: betting.BetType_Back, Odd: 3.65, Amount: 10, WinPL: 26.5, LosePL: -10, ReductionFactor: 0.5,
+				OrderType: betting.OrderType_MarketOnClose},
+		},
+		"reduce bet 9": {
+			bet:             betting.Bet{Type: betting.BetType_Lay, Odd: 4, Amount: 10, OrderType: betting.OrderType_LimitOnClose, PriceReduced: true},
+			reductionFactor: 0.25,
+			expectedBet: betting.Bet{Type: betting.BetType_Lay, Odd: 3, Amount: 10, WinPL: -20, LosePL: 10, ReductionFactor: 0.25,
+				OrderType: betting.OrderType_LimitOnClose, PriceReduced: true},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			bet, err := betting.ReduceBet(test.bet, test.reductionFactor)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assert.Equal(t, test.expectedBet.Type, bet.Type, "bet type field")
+			assert.InDelta(t, test.expectedBet.Odd, bet.Odd, float64EqualityThreshold, "bet odd field")
+			assert.InDelta(t, test.expectedBet.Amount, bet.Amount, amountEqualityThreshold, "bet amount field")
+			assert.InDelta(t, test.expectedBet.WinPL, bet.WinPL, amountEqualityThreshold, "Win P&L field")
+			assert.InDelta(t, test.expectedBet.LosePL, bet.LosePL, amountEqualityThreshold, "Lose P&L field")
+			assert.InDelta(t, test.expectedBet.ReductionFactor, bet.ReductionFactor, float64EqualityThreshold, "reduction factor field")
+			assert.Equal(t, test.expectedBet.OrderType, bet.OrderType, "order type field")
+			assert.Equal(t, test.expectedBet.PriceReduced, bet.PriceReduced, "price reduced field")
+		})
+	}
+}
+
+func TestDeadHeatFactor(t *testing.T) {
+	tests := map[string]struct {
+		places         int
+		position       int
+		tied           int
+		expectedFactor float64
+		expectedErr    bool
+	}{
+		"dead heat factor 1": {places: 0, position: 1, tied: 2, expectedErr: true},
+		"dead heat factor 2": {places: 1, position: 2, tied: 2, expectedErr: true},
+		"dead heat factor 3": {places: 1, position: 1, tied: 0, expectedErr: true},
+		"dead heat factor 4": {places: 1, position: 1, tied: 1, expectedFactor: 1},
+		"dead heat factor 5": {places: 1, position: 1, tied: 2, expectedFactor: 0.5},
+		"dead heat factor 6": {places: 3, position: 3, tied: 3, expectedFactor: 1.0 / 3},
+		"dead heat factor 7": {places: 3, position: 2, tied: 2, expectedFactor: 1},
+		"dead heat factor 8": {places: 4, position: 3, tied: 3, expectedFactor: 2.0 / 3},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			factor, err := betting.DeadHeatFactor(test.places, test.position, test.tied)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.InDelta(t, test.expectedFactor, factor, float64EqualityThreshold)
+		})
+	}
+}
+
+func TestApplyDeadHeat(t *testing.T) {
+	tests := map[string]struct {
+		bet            betting.Bet
+		deadHeatFactor float64
+		expectedWinPL  float64
+		expectedLosePL float64
+		expectedErr    bool
+	}{
+		"apply dead heat 1": {bet: betting.Bet{Type: betting.BetType_Back, Odd: 5, Amount: 10}, deadHeatFactor: 0, expectedErr: true},
+		"apply dead heat 2": {bet: betting.Bet{Type: betting.BetType_Back, Odd: 5, Amount: 10}, deadHeatFactor: 1.5, expectedErr: true},
+		"apply dead heat 3": {
+			bet:            betting.Bet{Type: betting.BetType_Back, Odd: 5, Amount: 10, WinPL: 40, LosePL: -10},
+			deadHeatFactor: 0.5,
+			expectedWinPL:  15,
+			expectedLosePL: -10,
+		},
+		"apply dead heat 4": {
+			bet:            betting.Bet{Type: betting.BetType_Lay, Odd: 5, Amount: 10, WinPL: -40, LosePL: 10},
+			deadHeatFactor: 0.5,
+			expectedWinPL:  -15,
+			expectedLosePL: 10,
+		},
+		"apply dead heat 5": {
+			bet:            betting.Bet{Type: betting.BetType_Back, Odd: 2, Amount: 10, WinPL: 10, LosePL: -10},
+			deadHeatFactor: 1.0 / 3,
+			expectedWinPL:  -3.33,
+			expectedLosePL: -10,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			bet, err := betting.ApplyDeadHeat(test.bet, test.deadHeatFactor)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assert.InDelta(t, test.expectedWinPL, bet.WinPL, amountEqualityThreshold, "Win P&L field")
+			assert.InDelta(t, test.expectedLosePL, bet.LosePL, amountEqualityThreshold, "Lose P&L field")
+		})
+	}
+}
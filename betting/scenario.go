@@ -0,0 +1,102 @@
+package betting
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/gustavooferreira/bfutils"
+)
+
+// ScenarioPath returns the greenbook P&L of the bets at every odd of a price path, in order.
+// Ticks are counted from the first odd of the path. All odds must exist in the ladder.
+func ScenarioPath(bets []Bet, path []float64) ([]ScenarioPoint, error) {
+	ladder, err := GreenBookAtAllOdds(bets)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]ScenarioPoint, 0, len(path))
+	startIndex := 0
+
+	for i, odd := range path {
+		index, err := oddIndex(odd)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			startIndex = index
+		}
+
+		points = append(points, ScenarioPoint{Ticks: index - startIndex, Odd: odd, GreenBookPL: ladder[index].GreenBookPL})
+	}
+
+	return points, nil
+}
+
+// ScenarioGrid returns the greenbook P&L of the bets at every odd up to ticks away from odd, in
+// both directions, ordered from the lowest to the highest odd.
+// Ticks are counted from odd, which must exist in the ladder. Odds beyond the ladder boundaries are left out.
+func ScenarioGrid(bets []Bet, odd float64, ticks int) ([]ScenarioPoint, error) {
+	if ticks < 0 {
+		return nil, fmt.Errorf("ticks [%d] cannot be negative", ticks)
+	}
+
+	ladder, err := GreenBookAtAllOdds(bets)
+	if err != nil {
+		return nil, err
+	}
+
+	centerIndex, err := oddIndex(odd)
+	if err != nil {
+		return nil, err
+	}
+
+	points := []ScenarioPoint{}
+
+	for shift := -ticks; shift <= ticks; shift++ {
+		index := centerIndex + shift
+		if index < 0 || index >= bfutils.OddsCount {
+			continue
+		}
+
+		points = append(points, ScenarioPoint{Ticks: shift, Odd: ladder[index].Odd, GreenBookPL: ladder[index].GreenBookPL})
+	}
+
+	return points, nil
+}
+
+// WriteScenarioCSV writes scenario points in CSV format, including a header.
+func WriteScenarioCSV(w io.Writer, points []ScenarioPoint) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"ticks", "odd", "greenbook_pl"}); err != nil {
+		return err
+	}
+
+	for _, point := range points {
+		fields := []string{
+			strconv.Itoa(point.Ticks),
+			strconv.FormatFloat(point.Odd, 'f', -1, 64),
+			strconv.FormatFloat(point.GreenBookPL, 'f', -1, 64),
+		}
+
+		if err := writer.Write(fields); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteScenarioJSON writes scenario points as a JSON array.
+func WriteScenarioJSON(w io.Writer, points []ScenarioPoint) error {
+	if points == nil {
+		points = []ScenarioPoint{}
+	}
+	return json.NewEncoder(w).Encode(points)
+}
@@ -0,0 +1,134 @@
+package betting_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScenarioPath(t *testing.T) {
+	bets := []betting.Bet{betting.NewBet(betting.BetType_Back, 4, 10)}
+
+	tests := map[string]struct {
+		path           []float64
+		expectedPoints []betting.ScenarioPoint
+		expectedErr    bool
+	}{
+		"scenario path 1": {path: []float64{4, 3.01}, expectedErr: true},
+		"scenario path 2": {path: []float64{}, expectedPoints: []betting.ScenarioPoint{}},
+		"scenario path 3": {
+			path: []float64{4, 3.5, 5},
+			expectedPoints: []betting.ScenarioPoint{
+				{Ticks: 0, Odd: 4, GreenBookPL: 0},
+				{Ticks: -10, Odd: 3.5, GreenBookPL: 1.428571},
+				{Ticks: 10, Odd: 5, GreenBookPL: -2},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			points, err := betting.ScenarioPath(bets, test.path)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assertScenarioPoints(t, test.expectedPoints, points)
+		})
+	}
+}
+
+func TestScenarioGrid(t *testing.T) {
+	bets := []betting.Bet{betting.NewBet(betting.BetType_Back, 4, 10)}
+
+	tests := map[string]struct {
+		odd            float64
+		ticks          int
+		expectedPoints []betting.ScenarioPoint
+		expectedErr    bool
+	}{
+		"scenario grid 1": {odd: 4, ticks: -1, expectedErr: true},
+		"scenario grid 2": {odd: 3.01, ticks: 1, expectedErr: true},
+		"scenario grid 3": {
+			odd:   4,
+			ticks: 1,
+			expectedPoints: []betting.ScenarioPoint{
+				{Ticks: -1, Odd: 3.95, GreenBookPL: 0.126582},
+				{Ticks: 0, Odd: 4, GreenBookPL: 0},
+				{Ticks: 1, Odd: 4.1, GreenBookPL: -0.243902},
+			},
+		},
+		"scenario grid 4": {
+			odd:   1.01,
+			ticks: 2,
+			expectedPoints: []betting.ScenarioPoint{
+				{Ticks: 0, Odd: 1.01, GreenBookPL: 29.603960},
+				{Ticks: 1, Odd: 1.02, GreenBookPL: 29.215686},
+				{Ticks: 2, Odd: 1.03, GreenBookPL: 28.834951},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			points, err := betting.ScenarioGrid(bets, test.odd, test.ticks)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assertScenarioPoints(t, test.expectedPoints, points)
+		})
+	}
+}
+
+func TestWriteScenario(t *testing.T) {
+	points := []betting.ScenarioPoint{{Ticks: -1, Odd: 3.95, GreenBookPL: 0.5}, {Ticks: 0, Odd: 4, GreenBookPL: 0}}
+
+	buf := &bytes.Buffer{}
+	err := betting.WriteScenarioCSV(buf, points)
+	require.NoError(t, err)
+	assert.Equal(t, "ticks,odd,greenbook_pl\n-1,3.95,0.5\n0,4,0\n", buf.String())
+
+	buf.Reset()
+	err = betting.WriteScenarioJSON(buf, points)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"ticks":-1,"odd":3.95,"greenbookPL":0.5},{"ticks":0,"odd":4,"greenbookPL":0}]`+"\n", buf.String())
+
+	buf.Reset()
+	err = betting.WriteScenarioJSON(buf, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "[]\n", buf.String())
+}
+
+func assertScenarioPoints(t *testing.T, expected []betting.ScenarioPoint, actual []betting.ScenarioPoint) {
+	t.Helper()
+
+	require.Len(t, actual, len(expected), "points length")
+	for i, point := range actual {
+		assert.Equal(t, expected[i].Ticks, point.Ticks, "ticks field")
+		assert.InDelta(t, expected[i].Odd, point.Odd, float64EqualityThreshold, "odd field")
+		assert.InDelta(t, expected[i].GreenBookPL, point.GreenBookPL, float64EqualityThreshold, "greenbook P&L field")
+	}
+}
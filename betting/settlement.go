@@ -0,0 +1,137 @@
+package betting
+
+import (
+	"fmt"
+
+	"github.com/gustavooferreira/bfutils"
+)
+
+// SettleMarket settles all bets in the selections of a market, given the result of each selection.
+// Markets with multiple winners, e.g. place markets, are settled by marking more than one selection
+// as a winner. Bets on removed selections are void.
+// Starting price bets must be reconciled with ProjectBSPBets and added to the bets of the selection
+// first, otherwise an error is returned.
+// commission is a representation in decimal, meaning a 5% commission is == 0.05.
+// Commission is charged on the net profit of the market, as the exchange does, and allocated to
+// the winning bets in proportion to their profit.
+func SettleMarket(selections []Selection, results map[int64]RunnerResult, commission float64) (MarketSettlement, error) {
+	if err := CheckCommission(commission); err != nil {
+		return MarketSettlement{}, err
+	}
+
+	settlement := MarketSettlement{}
+
+	seen := map[int64]bool{}
+	grossProfit := 0.0
+
+	for _, selection := range selections {
+		if seen[selection.ID] {
+			return MarketSettlement{}, fmt.Errorf("selection [%d] is duplicated", selection.ID)
+		}
+		seen[selection.ID] = true
+
+		if len(selection.BSPBets) > 0 {
+			return MarketSettlement{}, fmt.Errorf("selection [%d] has starting price bets that were not reconciled", selection.ID)
+		}
+
+		result, ok := results[selection.ID]
+		if !ok {
+			return MarketSettlement{}, fmt.Errorf("no result for selection [%d]", selection.ID)
+		}
+
+		if err := checkRunnerResult(result); err != nil {
+			return MarketSettlement{}, fmt.Errorf("selection [%d]: %w", selection.ID, err)
+		}
+
+		selectionSettlement := SelectionSettlement{SelectionID: selection.ID, Status: result.Status}
+
+		for _, bet := range selection.Bets {
+			pl, err := settleBet(bet, result)
+			if err != nil {
+				return MarketSettlement{}, err
+			}
+
+			if pl > 0 {
+				grossProfit += pl
+			}
+
+			selectionSettlement.Bets = append(selectionSettlement.Bets, BetSettlement{Bet: bet, GrossPL: pl})
+			selectionSettlement.GrossPL += pl
+		}
+
+		settlement.Selections = append(settlement.Selections, selectionSettlement)
+		settlement.GrossPL += selectionSettlement.GrossPL
+	}
+
+	if settlement.GrossPL > 0 {
+		settlement.Commission = settlement.GrossPL * commission
+	}
+	settlement.NetPL = settlement.GrossPL - settlement.Commission
+
+	for i := range settlement.Selections {
+		s := &settlement.Selections[i]
+
+		for j := range s.Bets {
+			b := &s.Bets[j]
+			if b.GrossPL > 0 {
+				b.Commission = settlement.Commission * b.GrossPL / grossProfit
+			}
+			b.NetPL = b.GrossPL - b.Commission
+			s.Commission += b.Commission
+		}
+
+		s.NetPL = s.GrossPL - s.Commission
+	}
+
+	return settlement, nil
+}
+
+// settleBet returns the P&L of a bet given the result of its selection.
+func settleBet(bet Bet, result RunnerResult) (float64, error) {
+	if bet.Type != BetType_Back && bet.Type != BetType_Lay {
+		return 0, fmt.Errorf("unknown bet type")
+	}
+
+	if bet.Amount == 0 || result.Status == RunnerStatus_Removed {
+		return 0, nil
+	}
+
+	if withinBoundary := bfutils.IsOddWithinBoundaries(bet.Odd); !withinBoundary {
+		return 0, fmt.Errorf("odd provided [%f] is outside of trading range", bet.Odd)
+	}
+
+	// P&L from the backer's point of view
+	pl := -bet.Amount
+	if result.Status == RunnerStatus_Winner {
+		factor := result.DeadHeatFactor
+		if factor == 0 {
+			factor = 1
+		}
+
+		// The part of the stake not covered by the dead heat factor is lost
+		pl = bet.Amount*factor*(bet.Odd-1) - bet.Amount*(1-factor)
+	}
+
+	if bet.Type == BetType_Lay {
+		return -pl, nil
+	}
+	return pl, nil
+}
+
+// checkRunnerResult returns an error if the runner result is not valid.
+func checkRunnerResult(result RunnerResult) error {
+	if result.Status != RunnerStatus_Winner && result.Status != RunnerStatus_Loser &&
+		result.Status != RunnerStatus_Removed {
+		return fmt.Errorf("unknown runner status")
+	}
+
+	if result.DeadHeatFactor < 0 || result.DeadHeatFactor > 1 {
+		return fmt.Errorf("dead heat factor [%f] must be between 0 and 1", result.DeadHeatFactor)
+	}
+
+	if result.DeadHeatFactor != 0 && result.Status != RunnerStatus_Winner {
+		return fmt.Errorf("dead heat factor only applies to winners")
+	}
+
+	return nil
+}
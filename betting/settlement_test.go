@@ -0,0 +1,158 @@
+package betting_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettleMarket(t *testing.T) {
+	tests := map[string]struct {
+		selections          []betting.Selection
+		results             map[int64]betting.RunnerResult
+		commission          float64
+		expectedGrossPL     float64
+		expectedCommission  float64
+		expectedNetPL       float64
+		expectedSelectionPL []float64
+		expectedBetPL       [][]float64
+		expectedErr         bool
+	}{
+		"settle market 1": {
+			selections:  []betting.Selection{{ID: 1}},
+			results:     map[int64]betting.RunnerResult{1: {Status: betting.RunnerStatus_Winner}},
+			commission:  1,
+			expectedErr: true,
+		},
+		"settle market 2": {
+			selections:  []betting.Selection{{ID: 1}, {ID: 2}},
+			results:     map[int64]betting.RunnerResult{1: {Status: betting.RunnerStatus_Winner}},
+			expectedErr: true,
+		},
+		"settle market 3": {
+			selections:  []betting.Selection{{ID: 1}, {ID: 1}},
+			results:     map[int64]betting.RunnerResult{1: {Status: betting.RunnerStatus_Winner}},
+			expectedErr: true,
+		},
+		"settle market 4": {
+			selections:  []betting.Selection{{ID: 1}},
+			results:     map[int64]betting.RunnerResult{1: {Status: betting.RunnerStatus_Loser, DeadHeatFactor: 0.5}},
+			expectedErr: true,
+		},
+		"settle market 5": {
+			selections: []betting.Selection{
+				{ID: 1, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}, {Type: betting.BetType_Lay, Odd: 4, Amount: 5}}},
+				{ID: 2, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 5, Amount: 10}}},
+			},
+			results: map[int64]betting.RunnerResult{
+				1: {Status: betting.RunnerStatus_Winner},
+				2: {Status: betting.RunnerStatus_Loser},
+			},
+			commission:          0.05,
+			expectedGrossPL:     -5,
+			expectedNetPL:       -5,
+			expectedSelectionPL: []float64{5, -10},
+			expectedBetPL:       [][]float64{{20, -15}, {-10}},
+		},
+		"settle market 6": {
+			selections: []betting.Selection{
+				{ID: 1, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}, {Type: betting.BetType_Lay, Odd: 4, Amount: 5}}},
+				{ID: 2, Bets: []betting.Bet{{Type: betting.BetType_Lay, Odd: 5, Amount: 10}}},
+			},
+			results: map[int64]betting.RunnerResult{
+				1: {Status: betting.RunnerStatus_Winner},
+				2: {Status: betting.RunnerStatus_Loser},
+			},
+			commission:          0.05,
+			expectedGrossPL:     15,
+			expectedCommission:  0.75,
+			expectedNetPL:       14.25,
+			expectedSelectionPL: []float64{4.5, 9.75},
+			expectedBetPL:       [][]float64{{19.5, -15}, {9.75}},
+		},
+		"settle market 7": {
+			selections: []betting.Selection{
+				{ID: 1, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 5, Amount: 10}}},
+				{ID: 2, Bets: []betting.Bet{{Type: betting.BetType_Lay, Odd: 5, Amount: 10}}},
+			},
+			results: map[int64]betting.RunnerResult{
+				1: {Status: betting.RunnerStatus_Winner, DeadHeatFactor: 0.5},
+				2: {Status: betting.RunnerStatus_Winner, DeadHeatFactor: 0.5},
+			},
+			expectedGrossPL:     0,
+			expectedNetPL:       0,
+			expectedSelectionPL: []float64{15, -15},
+			expectedBetPL:       [][]float64{{15}, {-15}},
+		},
+		"settle market 8": {
+			selections: []betting.Selection{
+				{ID: 1, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}}},
+				{ID: 2, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 2, Amount: 10}}},
+			},
+			results: map[int64]betting.RunnerResult{
+				1: {Status: betting.RunnerStatus_Removed},
+				2: {Status: betting.RunnerStatus_Loser},
+			},
+			commission:          0.05,
+			expectedGrossPL:     -10,
+			expectedNetPL:       -10,
+			expectedSelectionPL: []float64{0, -10},
+			expectedBetPL:       [][]float64{{0}, {-10}},
+		},
+		"settle market 9": {
+			selections: []betting.Selection{
+				{ID: 1, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 2, Amount: 10}}},
+				{ID: 2, Bets: []betting.Bet{{Type: betting.BetType_Lay, Odd: 3, Amount: 10}}},
+				{ID: 3, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 4, Amount: 5}}},
+			},
+			results: map[int64]betting.RunnerResult{
+				1: {Status: betting.RunnerStatus_Winner},
+				2: {Status: betting.RunnerStatus_Winner},
+				3: {Status: betting.RunnerStatus_Loser},
+			},
+			commission:          0.05,
+			expectedGrossPL:     -15,
+			expectedNetPL:       -15,
+			expectedSelectionPL: []float64{10, -20, -5},
+			expectedBetPL:       [][]float64{{10}, {-20}, {-5}},
+		},
+		"settle market 10": {
+			selections: []betting.Selection{{ID: 1, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				BSPBets: []betting.BSPBet{{Type: betting.BetType_Back, OrderType: betting.OrderType_MarketOnClose, Amount: 5}}}},
+			results:     map[int64]betting.RunnerResult{1: {Status: betting.RunnerStatus_Winner}},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			settlement, err := betting.SettleMarket(test.selections, test.results, test.commission)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.InDelta(t, test.expectedGrossPL, settlement.GrossPL, float64EqualityThreshold, "gross P&L field")
+			assert.InDelta(t, test.expectedCommission, settlement.Commission, float64EqualityThreshold, "commission field")
+			assert.InDelta(t, test.expectedNetPL, settlement.NetPL, float64EqualityThreshold, "net P&L field")
+			require.Len(t, settlement.Selections, len(test.expectedSelectionPL))
+
+			for i, selection := range settlement.Selections {
+				assert.Equal(t, test.selections[i].ID, selection.SelectionID, "selection ID field")
+				assert.InDelta(t, test.expectedSelectionPL[i], selection.NetPL, float64EqualityThreshold, "selection net P&L field")
+				require.Len(t, selection.Bets, len(test.expectedBetPL[i]))
+
+				for j, bet := range selection.Bets {
+					assert.InDelta(t, test.expectedBetPL[i][j], bet.NetPL, float64EqualityThreshold, "bet net P&L field")
+				}
+			}
+		})
+	}
+}
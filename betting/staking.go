@@ -0,0 +1,175 @@
+package betting
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gustavooferreira/bfutils"
+)
+
+// KellyFraction returns the fraction of the bankroll to risk on a bet, according to the Kelly criterion.
+// For back bets the fraction represents the stake, for lay bets it represents the liability.
+// prob is the probability of the selection winning.
+// commission is a representation in decimal, meaning a 5% commission is == 0.05.
+// If the bet has no edge, zero is returned.
+func KellyFraction(betType BetType, prob float64, odd float64, commission float64) (float64, error) {
+	if err := checkProbability(prob); err != nil {
+		return 0, err
+	}
+
+	if err := CheckCommission(commission); err != nil {
+		return 0, err
+	}
+
+	if withinBoundary := bfutils.IsOddWithinBoundaries(odd); !withinBoundary {
+		return 0, fmt.Errorf("odd provided [%f] is outside of trading range", odd)
+	}
+
+	var fraction float64
+
+	switch betType {
+	case BetType_Back:
+		// Net profit per unit staked
+		b := (odd - 1) * (1 - commission)
+		fraction = prob - (1-prob)/b
+	case BetType_Lay:
+		// Net profit per unit of liability
+		b := (1 - commission) / (odd - 1)
+		fraction = (1 - prob) - prob/b
+	default:
+		return 0, fmt.Errorf("unknown bet type")
+	}
+
+	if fraction < 0 {
+		return 0, nil
+	}
+	return fraction, nil
+}
+
+// KellyBet returns the bet to place according to the Kelly criterion.
+// The odd is rounded onto the ladder towards the worse price, i.e., Floor for back bets and Ceil
+// for lay bets.
+// The stake is rounded down to the penny, and if it's lower than the minimum stake allowed by
+// the currency, a bet with a zero amount is returned.
+func KellyBet(betType BetType, prob float64, odd float64, options StakingOptions) (bet Bet, err error) {
+	if err := checkStakingOptions(options); err != nil {
+		return bet, err
+	}
+
+	switch betType {
+	case BetType_Back:
+		_, odd, err = bfutils.OddFloor(odd)
+	case BetType_Lay:
+		_, odd, err = bfutils.OddCeil(odd)
+	default:
+		return bet, fmt.Errorf("unknown bet type")
+	}
+
+	if err != nil {
+		return bet, err
+	}
+
+	fraction, err := KellyFraction(betType, prob, odd, options.Commission)
+	if err != nil {
+		return bet, err
+	}
+
+	amount := fraction * options.KellyFraction * options.Bankroll
+	if betType == BetType_Lay {
+		amount /= odd - 1
+	}
+
+	return NewBet(betType, odd, options.Currency.RoundStake(amount)), nil
+}
+
+// KellyBets returns the back bets to place on several runners of the same market, according to
+// the simultaneous Kelly criterion for markets with a single winner.
+// Bets are returned in the same order as the runners, with a zero amount for runners not worth backing.
+// Odds are rounded onto the ladder using Floor and commission is approximated by reducing the
+// profit of each bet.
+func KellyBets(runners []RunnerEstimate, options StakingOptions) ([]Bet, error) {
+	if err := checkStakingOptions(options); err != nil {
+		return nil, err
+	}
+
+	odds := make([]float64, len(runners))
+	netOdds := make([]float64, len(runners))
+	order := make([]int, len(runners))
+	totalProb := 0.0
+
+	for i, runner := range runners {
+		if err := checkProbability(runner.Probability); err != nil {
+			return nil, err
+		}
+
+		_, odd, err := bfutils.OddFloor(runner.Odd)
+		if err != nil {
+			return nil, err
+		}
+
+		odds[i] = odd
+		netOdds[i] = 1 + (odd-1)*(1-options.Commission)
+		order[i] = i
+		totalProb += runner.Probability
+	}
+
+	if totalProb > 1+1e-9 {
+		return nil, fmt.Errorf("probabilities add up to more than 1")
+	}
+
+	// Sort runners by expected revenue
+	sort.SliceStable(order, func(i, j int) bool {
+		return runners[order[i]].Probability*netOdds[order[i]] > runners[order[j]].Probability*netOdds[order[j]]
+	})
+
+	reserve := 1.0
+	probSum := 0.0
+	invOddsSum := 0.0
+	selected := 0
+
+	for _, i := range order {
+		if runners[i].Probability*netOdds[i] <= reserve {
+			break
+		}
+
+		// Stop before the selected runners cover the whole book
+		if invOddsSum+1/netOdds[i] >= 1 {
+			break
+		}
+
+		probSum += runners[i].Probability
+		invOddsSum += 1 / netOdds[i]
+		reserve = (1 - probSum) / (1 - invOddsSum)
+		selected++
+	}
+
+	bets := make([]Bet, len(runners))
+	for i := range runners {
+		bets[i] = NewBet(BetType_Back, odds[i], 0)
+	}
+
+	for _, i := range order[:selected] {
+		fraction := runners[i].Probability - reserve/netOdds[i]
+		amount := fraction * options.KellyFraction * options.Bankroll
+		bets[i] = NewBet(BetType_Back, odds[i], options.Currency.RoundStake(amount))
+	}
+
+	return bets, nil
+}
+
+// checkStakingOptions returns an error if the staking options are not valid.
+func checkStakingOptions(options StakingOptions) error {
+	if options.Bankroll <= 0 {
+		return fmt.Errorf("bankroll must be positive")
+	}
+
+	if options.KellyFraction <= 0 {
+		return fmt.Errorf("kelly fraction must be positive")
+	}
+
+	if err := CheckCurrency(options.Currency); err != nil {
+		return err
+	}
+
+	return CheckCommission(options.Commission)
+}
@@ -0,0 +1,188 @@
+package betting_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKellyFraction(t *testing.T) {
+	tests := map[string]struct {
+		betType          betting.BetType
+		prob             float64
+		odd              float64
+		commission       float64
+		expectedFraction float64
+		expectedErr      bool
+	}{
+		"kelly fraction 1":  {betType: betting.BetType_Back, prob: 0, odd: 3, expectedErr: true},
+		"kelly fraction 2":  {betType: betting.BetType_Back, prob: 0.5, odd: 3, commission: 1, expectedErr: true},
+		"kelly fraction 3":  {betType: betting.BetType_Back, prob: 0.5, odd: 1, expectedErr: true},
+		"kelly fraction 4":  {prob: 0.5, odd: 3, expectedErr: true},
+		"kelly fraction 5":  {betType: betting.BetType_Back, prob: 0.5, odd: 3, expectedFraction: 0.25},
+		"kelly fraction 6":  {betType: betting.BetType_Back, prob: 0.5, odd: 3, commission: 0.05, expectedFraction: 0.236842},
+		"kelly fraction 7":  {betType: betting.BetType_Back, prob: 0.3, odd: 3, expectedFraction: 0},
+		"kelly fraction 8":  {betType: betting.BetType_Lay, prob: 0.25, odd: 3, expectedFraction: 0.25},
+		"kelly fraction 9":  {betType: betting.BetType_Lay, prob: 0.25, odd: 3, commission: 0.05, expectedFraction: 0.223684},
+		"kelly fraction 10": {betType: betting.BetType_Lay, prob: 0.4, odd: 2.4, expectedFraction: 0.04},
+		"kelly fraction 11": {betType: betting.BetType_Lay, prob: 0.5, odd: 2.4, expectedFraction: 0},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			value, err := betting.KellyFraction(test.betType, test.prob, test.odd, test.commission)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.InDelta(t, test.expectedFraction, value, float64EqualityThreshold)
+		})
+	}
+}
+
+func TestKellyBet(t *testing.T) {
+	options := betting.StakingOptions{Bankroll: 1000, KellyFraction: 0.5, Currency: betting.Currency_GBP}
+
+	tests := map[string]struct {
+		betType     betting.BetType
+		prob        float64
+		odd         float64
+		options     betting.StakingOptions
+		expectedBet betting.Bet
+		expectedErr bool
+	}{
+		"kelly bet 1": {betType: betting.BetType_Back, prob: 0.5, odd: 3, expectedErr: true},
+		"kelly bet 2": {
+			betType:     betting.BetType_Back,
+			prob:        0.5,
+			odd:         3,
+			options:     betting.StakingOptions{Bankroll: 1000, Currency: betting.Currency_GBP},
+			expectedErr: true,
+		},
+		"kelly bet 3": {betType: betting.BetType_Back, prob: 0.5, odd: 1001, options: options, expectedErr: true},
+		"kelly bet 4": {
+			betType:     betting.BetType_Back,
+			prob:        0.5,
+			odd:         3.02,
+			options:     options,
+			expectedBet: betting.Bet{Type: betting.BetType_Back, Odd: 3, Amount: 125, WinPL: 250, LosePL: -125},
+		},
+		"kelly bet 5": {
+			betType:     betting.BetType_Lay,
+			prob:        0.25,
+			odd:         2.99,
+			options:     options,
+			expectedBet: betting.Bet{Type: betting.BetType_Lay, Odd: 3, Amount: 62.5, WinPL: -125, LosePL: 62.5},
+		},
+		"kelly bet 6": {
+			betType:     betting.BetType_Back,
+			prob:        0.5,
+			odd:         3,
+			options:     betting.StakingOptions{Bankroll: 1000, KellyFraction: 0.1, Commission: 0.05, Currency: betting.Currency_GBP},
+			expectedBet: betting.Bet{Type: betting.BetType_Back, Odd: 3, Amount: 23.68, WinPL: 47.36, LosePL: -23.68},
+		},
+		"kelly bet 7": {
+			betType:     betting.BetType_Back,
+			prob:        0.5,
+			odd:         3,
+			options:     betting.StakingOptions{Bankroll: 10, KellyFraction: 0.1, Currency: betting.Currency_GBP},
+			expectedBet: betting.Bet{Type: betting.BetType_Back, Odd: 3},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			bet, err := betting.KellyBet(test.betType, test.prob, test.odd, test.options)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.Equal(t, test.expectedBet.Type, bet.Type, "bet type field")
+			assert.Equal(t, test.expectedBet.Odd, bet.Odd, "bet odd field")
+			assert.InDelta(t, test.expectedBet.Amount, bet.Amount, float64EqualityThreshold, "bet amount field")
+			assert.InDelta(t, test.expectedBet.WinPL, bet.WinPL, float64EqualityThreshold, "Win P&L field")
+			assert.InDelta(t, test.expectedBet.LosePL, bet.LosePL, float64EqualityThreshold, "Lose P&L field")
+		})
+	}
+}
+
+func TestKellyBets(t *testing.T) {
+	options := betting.StakingOptions{Bankroll: 100, KellyFraction: 1, Currency: betting.Currency_GBP}
+
+	tests := map[string]struct {
+		runners         []betting.RunnerEstimate
+		options         betting.StakingOptions
+		expectedAmounts []float64
+		expectedErr     bool
+	}{
+		"kelly bets 1": {
+			runners:     []betting.RunnerEstimate{{Probability: 0.5, Odd: 3}},
+			expectedErr: true,
+		},
+		"kelly bets 2": {
+			runners:     []betting.RunnerEstimate{{Probability: 0.7, Odd: 3}, {Probability: 0.5, Odd: 3}},
+			options:     options,
+			expectedErr: true,
+		},
+		"kelly bets 3": {
+			runners:     []betting.RunnerEstimate{{Probability: 0.5, Odd: 0.5}},
+			options:     options,
+			expectedErr: true,
+		},
+		"kelly bets 4": {
+			runners:         []betting.RunnerEstimate{{Probability: 0.5, Odd: 3}},
+			options:         options,
+			expectedAmounts: []float64{25},
+		},
+		"kelly bets 5": {
+			runners: []betting.RunnerEstimate{
+				{Probability: 0.1, Odd: 4},
+				{Probability: 0.5, Odd: 3},
+				{Probability: 0.3, Odd: 4},
+			},
+			options:         options,
+			expectedAmounts: []float64{0, 34, 18},
+		},
+		"kelly bets 6": {
+			runners: []betting.RunnerEstimate{
+				{Probability: 0.2, Odd: 4},
+				{Probability: 0.3, Odd: 2},
+			},
+			options:         options,
+			expectedAmounts: []float64{0, 0},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			bets, err := betting.KellyBets(test.runners, test.options)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+			require.Len(t, bets, len(test.expectedAmounts))
+
+			for i, bet := range bets {
+				assert.Equal(t, betting.BetType(betting.BetType_Back), bet.Type, "bet type field")
+				assert.InDelta(t, test.expectedAmounts[i], bet.Amount, float64EqualityThreshold, "bet amount field")
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package betting
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/gustavooferreira/bfutils"
+	"github.com/gustavooferreira/bfutils/internal"
+)
+
+// ScratchSelection computes the odd at which a hedge bet closes the selection without any profit
+// or loss, also known as scratching the trade.
+func ScratchSelection(selection Selection) (target HedgeTarget, err error) {
+	return TargetPLSelection(selection, 0, 0)
+}
+
+// TargetPLSelection computes the odd at which a hedge bet closes the selection with the given P&L.
+// pl is the P&L wanted after commission. A negative value can be used to find stop loss prices.
+// commission is a representation in decimal, meaning a 5% commission is == 0.05.
+// Commission is only charged when the P&L is a profit.
+func TargetPLSelection(selection Selection, pl float64, commission float64) (target HedgeTarget, err error) {
+	bets, err := selectionBets(selection)
+	if err != nil {
+		return target, err
+	}
+
+	if len(bets) == 0 {
+		return target, fmt.Errorf("no bets in this selection")
+	}
+
+	if err := CheckCommission(commission); err != nil {
+		return target, err
+	}
+
+	// Check current odds are valid
+	currentBackIndex, err := oddIndex(selection.CurrentBackOdd)
+	if err != nil {
+		return target, err
+	}
+
+	currentLayIndex, err := oddIndex(selection.CurrentLayOdd)
+	if err != nil {
+		return target, err
+	}
+
+	pos, err := newPosition(bets)
+	if err != nil {
+		return target, err
+	}
+
+	exposure := pos.exposure()
+	if internal.EqualWithTolerance(0.0, exposure) {
+		return target, &AlreadyEdgedError{}
+	}
+
+	grossPL := pl
+	if pl > 0 {
+		grossPL = pl / (1 - commission)
+	}
+
+	// Greenbook P&L at odd X is: losePL + exposure/X
+	denominator := grossPL - pos.losePL()
+	if internal.EqualWithTolerance(0.0, denominator) || exposure/denominator <= 0 {
+		return target, fmt.Errorf("P&L [%f] cannot be achieved at any odd", pl)
+	}
+
+	odd := exposure / denominator
+
+	floorIndex, oddFloor, err := bfutils.OddFloor(odd)
+	if err != nil {
+		return target, err
+	}
+
+	ceilIndex, oddCeil, err := bfutils.OddCeil(odd)
+	if err != nil {
+		return target, err
+	}
+
+	target.Odd = odd
+	target.OddFloor = oddFloor
+	target.OddCeil = oddCeil
+
+	// Laying at a lower odd or backing at a higher odd improves the P&L
+	if exposure > 0 {
+		target.Type = BetType_Lay
+		target.Ticks = currentLayIndex - floorIndex
+	} else {
+		target.Type = BetType_Back
+		target.Ticks = ceilIndex - currentBackIndex
+	}
+
+	return target, nil
+}
+
+// GreenBookSelectionWithDepth computes the hedge bets needed to greenbook a selection, taking into
+// account the volume available at each odd in the ladder, instead of assuming the whole hedge gets
+// matched at the current odd like GreenBookSelection does.
+// availableToBack holds the odds and volume available to back, which are consumed by back hedges,
+// and availableToLay holds the odds and volume available to lay, which are consumed by lay hedges.
+// Odds are consumed from the best one, i.e., the highest odd for back and the lowest odd for lay.
+// If there is not enough volume, the volume available is consumed and Filled is false.
+func GreenBookSelectionWithDepth(selection Selection, availableToBack []PriceSize, availableToLay []PriceSize) (
+	execution HedgeExecution, err error) {
+	bets, err := selectionBets(selection)
+	if err != nil {
+		return execution, err
+	}
+
+	if len(bets) == 0 {
+		return execution, fmt.Errorf("no bets in this selection")
+	}
+
+	pos, err := newPosition(bets)
+	if err != nil {
+		return execution, err
+	}
+
+	exposure := pos.exposure()
+	if internal.EqualWithTolerance(0.0, exposure) {
+		return execution, &AlreadyEdgedError{}
+	}
+
+	var levels []PriceSize
+	if exposure > 0 {
+		execution.Type = BetType_Lay
+		levels = append(levels, availableToLay...)
+		sort.SliceStable(levels, func(i, j int) bool { return levels[i].Odd < levels[j].Odd })
+	} else {
+		execution.Type = BetType_Back
+		levels = append(levels, availableToBack...)
+		sort.SliceStable(levels, func(i, j int) bool { return levels[i].Odd > levels[j].Odd })
+	}
+
+	for _, level := range levels {
+		if _, err := oddIndex(level.Odd); err != nil {
+			return HedgeExecution{}, err
+		}
+
+		if level.Size < 0 {
+			return HedgeExecution{}, fmt.Errorf("size [%f] at odd [%f] cannot be negative", level.Size, level.Odd)
+		}
+	}
+
+	remaining := math.Abs(exposure)
+	hedged := 0.0
+	execution.Bets = []Bet{}
+
+	for _, level := range levels {
+		if internal.EqualWithTolerance(0.0, remaining) {
+			break
+		}
+
+		if level.Size == 0 {
+			continue
+		}
+
+		amount := math.Min(level.Size, remaining/level.Odd)
+		remaining -= amount * level.Odd
+		hedged += amount * level.Odd
+
+		execution.Bets = append(execution.Bets, NewBet(execution.Type, level.Odd, amount))
+		execution.AvgOdd = (execution.AvgOdd*execution.Amount + level.Odd*amount) / (execution.Amount + amount)
+		execution.Amount += amount
+	}
+
+	execution.Filled = internal.EqualWithTolerance(0.0, remaining)
+	if execution.Filled {
+		remaining = 0
+	}
+
+	if execution.Type == BetType_Lay {
+		execution.LosePL = pos.losePL() + execution.Amount
+		execution.WinPL = execution.LosePL + remaining
+	} else {
+		execution.LosePL = pos.losePL() - execution.Amount
+		execution.WinPL = execution.LosePL - remaining
+	}
+
+	if len(execution.Bets) > 0 {
+		// Matching the same exposure at the best odd takes a bigger lay stake or a smaller back
+		// stake, which improves the P&L by the difference
+		bestAmount := hedged / execution.Bets[0].Odd
+		execution.Slippage = math.Abs(bestAmount - execution.Amount)
+	}
+
+	return execution, nil
+}
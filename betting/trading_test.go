@@ -0,0 +1,277 @@
+package betting_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScratchSelection(t *testing.T) {
+	tests := map[string]struct {
+		selection      betting.Selection
+		expectedTarget betting.HedgeTarget
+		expectedErr    bool
+	}{
+		"scratch 1": {expectedErr: true},
+		"scratch 2": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+			},
+			expectedErr: true,
+		},
+		"scratch 3": {
+			selection: betting.Selection{
+				Bets: []betting.Bet{
+					{Type: betting.BetType_Back, Odd: 2, Amount: 10},
+					{Type: betting.BetType_Lay, Odd: 2, Amount: 10},
+				},
+				CurrentBackOdd: 2,
+				CurrentLayOdd:  2.02,
+			},
+			expectedErr: true,
+		},
+		"scratch 4": {
+			selection: betting.Selection{
+				Bets: []betting.Bet{
+					{Type: betting.BetType_Back, Odd: 3, Amount: 10},
+					{Type: betting.BetType_Lay, Odd: 2, Amount: 10},
+				},
+				CurrentBackOdd: 2,
+				CurrentLayOdd:  2.02,
+			},
+			expectedErr: true,
+		},
+		"scratch 5": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3.05,
+			},
+			expectedTarget: betting.HedgeTarget{Type: betting.BetType_Lay, Odd: 3, OddFloor: 3, OddCeil: 3, Ticks: 1},
+		},
+		"scratch 6": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Lay, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 3.1,
+				CurrentLayOdd:  3.15,
+			},
+			expectedTarget: betting.HedgeTarget{Type: betting.BetType_Back, Odd: 3, OddFloor: 3, OddCeil: 3, Ticks: -2},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			target, err := betting.ScratchSelection(test.selection)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.Equal(t, test.expectedTarget.Type, target.Type, "hedge type field")
+			assert.InDelta(t, test.expectedTarget.Odd, target.Odd, float64EqualityThreshold, "odd field")
+			assert.Equal(t, test.expectedTarget.OddFloor, target.OddFloor, "odd floor field")
+			assert.Equal(t, test.expectedTarget.OddCeil, target.OddCeil, "odd ceil field")
+			assert.Equal(t, test.expectedTarget.Ticks, target.Ticks, "ticks field")
+		})
+	}
+}
+
+func TestTargetPLSelection(t *testing.T) {
+	tests := map[string]struct {
+		selection      betting.Selection
+		pl             float64
+		commission     float64
+		expectedTarget betting.HedgeTarget
+		expectedErr    bool
+	}{
+		"target P&L 1": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3.05,
+			},
+			pl:          5,
+			commission:  1,
+			expectedErr: true,
+		},
+		"target P&L 2": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3.05,
+			},
+			pl:          20,
+			expectedErr: true,
+		},
+		"target P&L 3": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3.05,
+			},
+			pl:             5,
+			expectedTarget: betting.HedgeTarget{Type: betting.BetType_Lay, Odd: 2, OddFloor: 2, OddCeil: 2, Ticks: 51},
+		},
+		"target P&L 4": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3.05,
+			},
+			pl:             4.75,
+			commission:     0.05,
+			expectedTarget: betting.HedgeTarget{Type: betting.BetType_Lay, Odd: 2, OddFloor: 2, OddCeil: 2, Ticks: 51},
+		},
+		"target P&L 5": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Back, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 2.98,
+				CurrentLayOdd:  3.05,
+			},
+			pl:             -2,
+			commission:     0.05,
+			expectedTarget: betting.HedgeTarget{Type: betting.BetType_Lay, Odd: 3.75, OddFloor: 3.75, OddCeil: 3.75, Ticks: -14},
+		},
+		"target P&L 6": {
+			selection: betting.Selection{
+				Bets: []betting.Bet{
+					{Type: betting.BetType_Back, Odd: 3, Amount: 10},
+					{Type: betting.BetType_Lay, Odd: 2, Amount: 5},
+				},
+				CurrentBackOdd: 3.45,
+				CurrentLayOdd:  3.5,
+			},
+			pl:             1,
+			expectedTarget: betting.HedgeTarget{Type: betting.BetType_Lay, Odd: 3.333333, OddFloor: 3.3, OddCeil: 3.35, Ticks: 4},
+		},
+		"target P&L 7": {
+			selection: betting.Selection{
+				Bets:           []betting.Bet{{Type: betting.BetType_Lay, Odd: 3, Amount: 10}},
+				CurrentBackOdd: 3,
+				CurrentLayOdd:  3.05,
+			},
+			pl:             5,
+			expectedTarget: betting.HedgeTarget{Type: betting.BetType_Back, Odd: 6, OddFloor: 6, OddCeil: 6, Ticks: 40},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			target, err := betting.TargetPLSelection(test.selection, test.pl, test.commission)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.Equal(t, test.expectedTarget.Type, target.Type, "hedge type field")
+			assert.InDelta(t, test.expectedTarget.Odd, target.Odd, float64EqualityThreshold, "odd field")
+			assert.Equal(t, test.expectedTarget.OddFloor, target.OddFloor, "odd floor field")
+			assert.Equal(t, test.expectedTarget.OddCeil, target.OddCeil, "odd ceil field")
+			assert.Equal(t, test.expectedTarget.Ticks, target.Ticks, "ticks field")
+		})
+	}
+}
+
+func TestGreenBookSelectionWithDepth(t *testing.T) {
+	backSelection := betting.Selection{Bets: []betting.Bet{betting.NewBet(betting.BetType_Back, 4, 10)}}
+	laySelection := betting.Selection{Bets: []betting.Bet{betting.NewBet(betting.BetType_Lay, 3, 10)}}
+
+	tests := map[string]struct {
+		selection         betting.Selection
+		availableToBack   []betting.PriceSize
+		availableToLay    []betting.PriceSize
+		expectedExecution betting.HedgeExecution
+		expectedOdds      []float64
+		expectedErr       bool
+	}{
+		"green book with depth 1": {selection: betting.Selection{}, expectedErr: true},
+		"green book with depth 2": {
+			selection: betting.Selection{Bets: []betting.Bet{
+				betting.NewBet(betting.BetType_Back, 4, 10),
+				betting.NewBet(betting.BetType_Lay, 4, 10),
+			}},
+			expectedErr: true,
+		},
+		"green book with depth 3": {
+			selection:      backSelection,
+			availableToLay: []betting.PriceSize{{Odd: 3.01, Size: 100}},
+			expectedErr:    true,
+		},
+		"green book with depth 4": {
+			selection:      backSelection,
+			availableToLay: []betting.PriceSize{{Odd: 3.05, Size: -1}},
+			expectedErr:    true,
+		},
+		"green book with depth 5": {
+			selection:      backSelection,
+			availableToLay: []betting.PriceSize{{Odd: 3.2, Size: 100}, {Odd: 3.05, Size: 5}, {Odd: 3.1, Size: 10}},
+			expectedExecution: betting.HedgeExecution{Type: betting.BetType_Lay, Amount: 12.983871, AvgOdd: 3.080745,
+				WinPL: 2.983871, LosePL: 2.983871, Filled: true, Slippage: 0.130883},
+			expectedOdds: []float64{3.05, 3.1},
+		},
+		"green book with depth 6": {
+			selection:      backSelection,
+			availableToLay: []betting.PriceSize{{Odd: 3.05, Size: 5}},
+			expectedExecution: betting.HedgeExecution{Type: betting.BetType_Lay, Amount: 5, AvgOdd: 3.05,
+				WinPL: 19.75, LosePL: -5},
+			expectedOdds: []float64{3.05},
+		},
+		"green book with depth 7": {
+			selection:       laySelection,
+			availableToBack: []betting.PriceSize{{Odd: 3.1, Size: 100}, {Odd: 3.2, Size: 5}},
+			availableToLay:  []betting.PriceSize{{Odd: 3.25, Size: 100}},
+			expectedExecution: betting.HedgeExecution{Type: betting.BetType_Back, Amount: 9.516129, AvgOdd: 3.152542,
+				WinPL: 0.483871, LosePL: 0.483871, Filled: true, Slippage: 0.141129},
+			expectedOdds: []float64{3.2, 3.1},
+		},
+		"green book with depth 8": {
+			selection: laySelection,
+			expectedExecution: betting.HedgeExecution{Type: betting.BetType_Back,
+				WinPL: -20, LosePL: 10},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			execution, err := betting.GreenBookSelectionWithDepth(test.selection, test.availableToBack, test.availableToLay)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			require.Len(t, execution.Bets, len(test.expectedOdds), "bets length")
+			for i, bet := range execution.Bets {
+				assert.Equal(t, test.expectedExecution.Type, bet.Type, "bet type field")
+				assert.InDelta(t, test.expectedOdds[i], bet.Odd, float64EqualityThreshold, "odd field")
+			}
+
+			assert.Equal(t, test.expectedExecution.Type, execution.Type, "type field")
+			assert.InDelta(t, test.expectedExecution.Amount, execution.Amount, float64EqualityThreshold, "amount field")
+			assert.InDelta(t, test.expectedExecution.AvgOdd, execution.AvgOdd, float64EqualityThreshold, "average odd field")
+			assert.InDelta(t, test.expectedExecution.WinPL, execution.WinPL, float64EqualityThreshold, "win P&L field")
+			assert.InDelta(t, test.expectedExecution.LosePL, execution.LosePL, float64EqualityThreshold, "lose P&L field")
+			assert.Equal(t, test.expectedExecution.Filled, execution.Filled, "filled field")
+			assert.InDelta(t, test.expectedExecution.Slippage, execution.Slippage, float64EqualityThreshold, "slippage field")
+		})
+	}
+}
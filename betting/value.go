@@ -0,0 +1,88 @@
+package betting
+
+import (
+	"fmt"
+
+	"github.com/gustavooferreira/bfutils"
+)
+
+// ExpectedValue returns the expected profit or loss of a bet, after commission.
+// prob is the probability of the selection winning.
+// amount is the backer's stake, for both back and lay bets.
+// commission is a representation in decimal, meaning a 5% commission is == 0.05.
+func ExpectedValue(betType BetType, prob float64, odd float64, amount float64, commission float64) (float64, error) {
+	edge, err := Edge(betType, prob, odd, commission)
+	if err != nil {
+		return 0, err
+	}
+
+	if betType == BetType_Lay {
+		return edge * amount * (odd - 1), nil
+	}
+	return edge * amount, nil
+}
+
+// Edge returns the expected profit or loss per unit risked, after commission.
+// The amount risked is the stake for back bets and the liability for lay bets.
+// Example: an edge of 0.05 means the bet is expected to return 5% of the amount risked.
+func Edge(betType BetType, prob float64, odd float64, commission float64) (float64, error) {
+	if err := checkProbability(prob); err != nil {
+		return 0, err
+	}
+
+	if err := CheckCommission(commission); err != nil {
+		return 0, err
+	}
+
+	if withinBoundary := bfutils.IsOddWithinBoundaries(odd); !withinBoundary {
+		return 0, fmt.Errorf("odd provided [%f] is outside of trading range", odd)
+	}
+
+	switch betType {
+	case BetType_Back:
+		return prob*(odd-1)*(1-commission) - (1 - prob), nil
+	case BetType_Lay:
+		return ((1-prob)*(1-commission) - prob*(odd-1)) / (odd - 1), nil
+	default:
+		return 0, fmt.Errorf("unknown bet type")
+	}
+}
+
+// EdgeOdd returns the worst odd in the ladder at which a bet still has, at least, the given edge.
+// For back bets this is the minimum odd to take, rounded with Ceil.
+// For lay bets this is the maximum odd to take, rounded with Floor.
+func EdgeOdd(betType BetType, prob float64, edge float64, commission float64) (float64, error) {
+	if err := checkProbability(prob); err != nil {
+		return 0, err
+	}
+
+	if err := CheckCommission(commission); err != nil {
+		return 0, err
+	}
+
+	var odd float64
+	var roundType bfutils.RoundType
+
+	switch betType {
+	case BetType_Back:
+		if edge <= -1 {
+			return 0, fmt.Errorf("cannot lose more than 100%% of stake when backing")
+		}
+		odd = 1 + (edge+1-prob)/(prob*(1-commission))
+		roundType = bfutils.RoundType_Ceil
+	case BetType_Lay:
+		if edge+prob <= 0 {
+			return 0, fmt.Errorf("edge [%f] cannot be achieved at any odd", edge)
+		}
+		odd = 1 + (1-prob)*(1-commission)/(edge+prob)
+		roundType = bfutils.RoundType_Floor
+	default:
+		return 0, fmt.Errorf("unknown bet type")
+	}
+
+	_, odd, err := bfutils.OddShift(roundType, odd, 0)
+	if err != nil {
+		return 0, err
+	}
+	return odd, nil
+}
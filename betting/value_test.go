@@ -0,0 +1,116 @@
+package betting_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedValue(t *testing.T) {
+	tests := map[string]struct {
+		betType     betting.BetType
+		prob        float64
+		odd         float64
+		amount      float64
+		commission  float64
+		expectedEV  float64
+		expectedErr bool
+	}{
+		"expected value 1": {betType: betting.BetType_Back, prob: 1, odd: 3, amount: 10, expectedErr: true},
+		"expected value 2": {betType: betting.BetType_Back, prob: 0.5, odd: 3, amount: 10, expectedEV: 5},
+		"expected value 3": {betType: betting.BetType_Back, prob: 0.5, odd: 3, amount: 10, commission: 0.05, expectedEV: 4.5},
+		"expected value 4": {betType: betting.BetType_Back, prob: 0.2, odd: 3, amount: 10, expectedEV: -4},
+		"expected value 5": {betType: betting.BetType_Lay, prob: 0.25, odd: 3, amount: 10, expectedEV: 2.5},
+		"expected value 6": {betType: betting.BetType_Lay, prob: 0.25, odd: 3, amount: 10, commission: 0.05, expectedEV: 2.125},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			value, err := betting.ExpectedValue(test.betType, test.prob, test.odd, test.amount, test.commission)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.InDelta(t, test.expectedEV, value, float64EqualityThreshold)
+		})
+	}
+}
+
+func TestEdge(t *testing.T) {
+	tests := map[string]struct {
+		betType      betting.BetType
+		prob         float64
+		odd          float64
+		commission   float64
+		expectedEdge float64
+		expectedErr  bool
+	}{
+		"edge 1": {betType: betting.BetType_Back, prob: 0.5, odd: 1000.5, expectedErr: true},
+		"edge 2": {betType: betting.BetType_Back, prob: 0.5, odd: 3, commission: -0.1, expectedErr: true},
+		"edge 3": {prob: 0.5, odd: 3, expectedErr: true},
+		"edge 4": {betType: betting.BetType_Back, prob: 0.5, odd: 3, expectedEdge: 0.5},
+		"edge 5": {betType: betting.BetType_Back, prob: 0.5, odd: 2, expectedEdge: 0},
+		"edge 6": {betType: betting.BetType_Lay, prob: 0.25, odd: 3, expectedEdge: 0.125},
+		"edge 7": {betType: betting.BetType_Lay, prob: 0.5, odd: 3, expectedEdge: -0.25},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			value, err := betting.Edge(test.betType, test.prob, test.odd, test.commission)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.InDelta(t, test.expectedEdge, value, float64EqualityThreshold)
+		})
+	}
+}
+
+func TestEdgeOdd(t *testing.T) {
+	tests := map[string]struct {
+		betType     betting.BetType
+		prob        float64
+		edge        float64
+		commission  float64
+		expectedOdd float64
+		expectedErr bool
+	}{
+		"edge odd 1": {betType: betting.BetType_Back, prob: 0.5, edge: -1, expectedErr: true},
+		"edge odd 2": {betType: betting.BetType_Back, prob: 0.0005, edge: 0, expectedErr: true},
+		"edge odd 3": {betType: betting.BetType_Lay, prob: 0.25, edge: -0.3, expectedErr: true},
+		"edge odd 4": {prob: 0.25, edge: 0.1, expectedErr: true},
+		"edge odd 5": {betType: betting.BetType_Back, prob: 0.5, edge: 0.1, expectedOdd: 2.2},
+		"edge odd 6": {betType: betting.BetType_Back, prob: 0.5, edge: 0.1, commission: 0.05, expectedOdd: 2.28},
+		"edge odd 7": {betType: betting.BetType_Lay, prob: 0.25, edge: 0.1, expectedOdd: 3.1},
+		"edge odd 8": {betType: betting.BetType_Lay, prob: 0.25, edge: 0.1, commission: 0.05, expectedOdd: 3},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			value, err := betting.EdgeOdd(test.betType, test.prob, test.edge, test.commission)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.Equal(t, test.expectedOdd, value)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package horserace
+
+import (
+	"fmt"
+
+	"github.com/gustavooferreira/bfutils/conversion"
+)
+
+// DistanceCategory represents how far a race is run relative to the other races of the same discipline.
+// The mile category only applies to flat races, as jump races are grouped from two miles upwards.
+type DistanceCategory uint
+
+const (
+	// DistanceCategory_Sprint represents a sprint on the flat, or a two-mile race over jumps.
+	DistanceCategory_Sprint = iota + 1
+	// DistanceCategory_Mile represents a flat race run over around a mile. Jump races are never in this category.
+	DistanceCategory_Mile
+	// DistanceCategory_Middle represents a middle distance race.
+	DistanceCategory_Middle
+	// DistanceCategory_Staying represents a staying race.
+	DistanceCategory_Staying
+)
+
+// String returns the string representation of DistanceCategory.
+func (dc DistanceCategory) String() string {
+	return [...]string{"", "Sprint", "Mile", "Middle", "Staying"}[dc]
+}
+
+// RaceCategory represents the category of a race used to pick betting rules.
+type RaceCategory struct {
+	// Type of the race: flat, hurdle, chase or NHF (bumper).
+	RaceType RaceType
+	// Distance category of the race.
+	DistanceCategory DistanceCategory
+}
+
+// DistanceThresholds represents the longest distance, in furlongs, of each distance category.
+// Races longer than MiddleMax are staying races.
+// Setting MileMax equal to SprintMax disables the mile category, which is required for jump races.
+type DistanceThresholds struct {
+	// Longest sprint.
+	SprintMax float64
+	// Longest race run over around a mile.
+	MileMax float64
+	// Longest middle distance race.
+	MiddleMax float64
+}
+
+// CategoryThresholds represents the distance thresholds used for flat and jump races.
+type CategoryThresholds struct {
+	// Thresholds of flat races.
+	Flat DistanceThresholds
+	// Thresholds of hurdles, chases and NHF races. MileMax must be equal to SprintMax.
+	Jumps DistanceThresholds
+}
+
+// DefaultCategoryThresholds holds the thresholds commonly used in UK and IRE racing.
+// Flat races up to 7f are sprints, up to 1m1f are milers' races and up to 1m5f are middle distance races.
+// Jump races up to 2m2f are two-mile races (DistanceCategory_Sprint) and up to 2m7f are middle distance races.
+var DefaultCategoryThresholds = CategoryThresholds{
+	Flat:  DistanceThresholds{SprintMax: 7, MileMax: 9, MiddleMax: 13},
+	Jumps: DistanceThresholds{SprintMax: 18, MileMax: 18, MiddleMax: 23},
+}
+
+// classToRaceType is a map from the classifications that imply the type of race to RaceType
+var classToRaceType = map[Classification]RaceType{
+	Classification_Flat:                    RaceType_Flat,
+	Classification_Hurdle:                  RaceType_Hurdle,
+	Classification_BeginnersHandicapHurdle: RaceType_Hurdle,
+	Classification_Chase:                   RaceType_Chase,
+	Classification_NovicesHandicapChase:    RaceType_Chase,
+	Classification_Beginners:               RaceType_Chase,
+	Classification_Hunters:                 RaceType_Chase,
+	Classification_NationalHuntFlat:        RaceType_NHF,
+	Classification_IrishNHFlat:             RaceType_NHF,
+}
+
+// CategoriseRace returns the category of a race, given its classifications and distance.
+// The type of race is inferred from classifications such as Hurdle or Chase, and races without
+// any of them are flat races. An error is returned if the classifications imply different types of race.
+// Jump races are never categorised as DistanceCategory_Mile, and an error is returned if the jump
+// thresholds have a mile category.
+func CategoriseRace(classes []Classification, distance conversion.Distance, thresholds CategoryThresholds) (rc RaceCategory, err error) {
+	for _, class := range classes {
+		raceType, ok := classToRaceType[class]
+		if !ok {
+			continue
+		}
+
+		if rc.RaceType != 0 && rc.RaceType != raceType {
+			return RaceCategory{}, fmt.Errorf("classifications imply more than one race type: [%s] and [%s]", rc.RaceType, raceType)
+		}
+		rc.RaceType = raceType
+	}
+
+	if rc.RaceType == 0 {
+		rc.RaceType = RaceType_Flat
+	}
+
+	dt := thresholds.Flat
+	if rc.RaceType != RaceType_Flat {
+		dt = thresholds.Jumps
+		if dt.MileMax != dt.SprintMax {
+			return RaceCategory{}, fmt.Errorf("jump races have no mile category, MileMax must be equal to SprintMax")
+		}
+	}
+
+	if rc.DistanceCategory, err = categoriseDistance(distance, dt); err != nil {
+		return RaceCategory{}, err
+	}
+	return rc, nil
+}
+
+// Category returns the category of the race, see CategoriseRace.
+// The race type in the market name is taken into account as well, unless it's the flat default.
+func (mn MarketName) Category(thresholds CategoryThresholds) (RaceCategory, error) {
+	classes := append([]Classification{}, mn.Classifications...)
+
+	switch mn.RaceType {
+	case RaceType_Hurdle:
+		classes = append(classes, Classification_Hurdle)
+	case RaceType_Chase:
+		classes = append(classes, Classification_Chase)
+	case RaceType_NHF:
+		classes = append(classes, Classification_NationalHuntFlat)
+	}
+
+	return CategoriseRace(classes, mn.Distance, thresholds)
+}
+
+// categoriseDistance returns the distance category, given the thresholds of the discipline.
+func categoriseDistance(distance conversion.Distance, dt DistanceThresholds) (DistanceCategory, error) {
+	furlongs := conversion.MileToFurlong(float64(distance.Miles)) + float64(distance.Furlongs) + conversion.YardToFurlong(float64(distance.Yards))
+	if furlongs <= 0 {
+		return 0, fmt.Errorf("distance cannot be zero")
+	}
+
+	if dt.SprintMax > dt.MileMax || dt.MileMax > dt.MiddleMax {
+		return 0, fmt.Errorf("distance thresholds must be in increasing order")
+	}
+
+	switch {
+	case furlongs <= dt.SprintMax:
+		return DistanceCategory_Sprint, nil
+	case furlongs <= dt.MileMax:
+		return DistanceCategory_Mile, nil
+	case furlongs <= dt.MiddleMax:
+		return DistanceCategory_Middle, nil
+	}
+	return DistanceCategory_Staying, nil
+}
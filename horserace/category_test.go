@@ -0,0 +1,125 @@
+package horserace_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/conversion"
+	"github.com/gustavooferreira/bfutils/horserace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoriseRace(t *testing.T) {
+	tests := map[string]struct {
+		classes          []horserace.Classification
+		distance         conversion.Distance
+		thresholds       horserace.CategoryThresholds
+		expectedCategory horserace.RaceCategory
+		expectedErr      bool
+	}{
+		"categorise: flat sprint": {classes: []horserace.Classification{horserace.Classification_Handicap},
+			distance: conversion.NewDistance(0, 5, 0), thresholds: horserace.DefaultCategoryThresholds,
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Flat, DistanceCategory: horserace.DistanceCategory_Sprint}},
+		"categorise: flat 7f": {distance: conversion.NewDistance(0, 7, 0), thresholds: horserace.DefaultCategoryThresholds,
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Flat, DistanceCategory: horserace.DistanceCategory_Sprint}},
+		"categorise: flat mile": {classes: []horserace.Classification{horserace.Classification_Group1},
+			distance: conversion.NewDistance(1, 0, 0), thresholds: horserace.DefaultCategoryThresholds,
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Flat, DistanceCategory: horserace.DistanceCategory_Mile}},
+		"categorise: flat middle": {classes: []horserace.Classification{horserace.Classification_Flat},
+			distance: conversion.NewDistance(1, 4, 0), thresholds: horserace.DefaultCategoryThresholds,
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Flat, DistanceCategory: horserace.DistanceCategory_Middle}},
+		"categorise: flat staying": {distance: conversion.NewDistance(2, 0, 0), thresholds: horserace.DefaultCategoryThresholds,
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Flat, DistanceCategory: horserace.DistanceCategory_Staying}},
+		"categorise: hurdle two miles": {classes: []horserace.Classification{horserace.Classification_Novice, horserace.Classification_Hurdle},
+			distance: conversion.NewDistance(2, 0, 0), thresholds: horserace.DefaultCategoryThresholds,
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Hurdle, DistanceCategory: horserace.DistanceCategory_Sprint}},
+		"categorise: chase middle": {classes: []horserace.Classification{horserace.Classification_NovicesHandicapChase},
+			distance: conversion.NewDistance(2, 4, 0), thresholds: horserace.DefaultCategoryThresholds,
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Chase, DistanceCategory: horserace.DistanceCategory_Middle}},
+		"categorise: hunters chase staying": {classes: []horserace.Classification{horserace.Classification_Hunters},
+			distance: conversion.NewDistance(3, 2, 0), thresholds: horserace.DefaultCategoryThresholds,
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Chase, DistanceCategory: horserace.DistanceCategory_Staying}},
+		"categorise: bumper": {classes: []horserace.Classification{horserace.Classification_IrishNHFlat},
+			distance: conversion.NewDistance(2, 0, 0), thresholds: horserace.DefaultCategoryThresholds,
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_NHF, DistanceCategory: horserace.DistanceCategory_Sprint}},
+		"categorise: yards": {distance: conversion.NewDistance(0, 7, 40),
+			thresholds:       horserace.CategoryThresholds{Flat: horserace.DistanceThresholds{SprintMax: 7.25, MileMax: 9, MiddleMax: 13}},
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Flat, DistanceCategory: horserace.DistanceCategory_Sprint}},
+		"categorise: custom thresholds": {distance: conversion.NewDistance(1, 0, 0),
+			thresholds:       horserace.CategoryThresholds{Flat: horserace.DistanceThresholds{SprintMax: 8, MileMax: 8, MiddleMax: 12}},
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Flat, DistanceCategory: horserace.DistanceCategory_Sprint}},
+		"categorise: conflicting race types": {classes: []horserace.Classification{horserace.Classification_Hurdle, horserace.Classification_Chase},
+			distance: conversion.NewDistance(2, 0, 0), thresholds: horserace.DefaultCategoryThresholds, expectedErr: true},
+		"categorise: jumps have no mile": {classes: []horserace.Classification{horserace.Classification_Hurdle},
+			distance: conversion.NewDistance(2, 1, 0), thresholds: horserace.DefaultCategoryThresholds,
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Hurdle, DistanceCategory: horserace.DistanceCategory_Sprint}},
+		"categorise: jumps past two miles": {classes: []horserace.Classification{horserace.Classification_Chase},
+			distance: conversion.NewDistance(2, 3, 0), thresholds: horserace.DefaultCategoryThresholds,
+			expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Chase, DistanceCategory: horserace.DistanceCategory_Middle}},
+		"categorise: jumps mile thresholds": {classes: []horserace.Classification{horserace.Classification_Hurdle},
+			distance:   conversion.NewDistance(2, 1, 0),
+			thresholds: horserace.CategoryThresholds{Jumps: horserace.DistanceThresholds{SprintMax: 16, MileMax: 18, MiddleMax: 23}}, expectedErr: true},
+		"categorise: zero distance": {thresholds: horserace.DefaultCategoryThresholds, expectedErr: true},
+		"categorise: thresholds out of order": {distance: conversion.NewDistance(1, 0, 0),
+			thresholds: horserace.CategoryThresholds{Flat: horserace.DistanceThresholds{SprintMax: 9, MileMax: 7, MiddleMax: 13}}, expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			value, err := horserace.CategoriseRace(test.classes, test.distance, test.thresholds)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assert.Equal(t, test.expectedCategory, value)
+		})
+	}
+}
+
+func TestMarketNameCategory(t *testing.T) {
+	tests := map[string]struct {
+		marketName       string
+		expectedCategory horserace.RaceCategory
+		expectedErr      bool
+	}{
+		"category: flat":     {marketName: "6f Hcap", expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Flat, DistanceCategory: horserace.DistanceCategory_Sprint}},
+		"category: chase":    {marketName: "3m Hcap Chs", expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Chase, DistanceCategory: horserace.DistanceCategory_Staying}},
+		"category: hurdle":   {marketName: "2m4f Nov Hrd", expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Hurdle, DistanceCategory: horserace.DistanceCategory_Middle}},
+		"category: bumper":   {marketName: "2m NHF", expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_NHF, DistanceCategory: horserace.DistanceCategory_Sprint}},
+		"category: hunters":  {marketName: "2m4f Hunt", expectedCategory: horserace.RaceCategory{RaceType: horserace.RaceType_Chase, DistanceCategory: horserace.DistanceCategory_Middle}},
+		"category: conflict": {marketName: "2m4f Hunt Hrd", expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mn, err := horserace.ParseMarketName(test.marketName)
+			require.NoError(t, err)
+
+			var errBool bool
+			var errMsg string
+			value, err := mn.Category(horserace.DefaultCategoryThresholds)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assert.Equal(t, test.expectedCategory, value)
+		})
+	}
+}
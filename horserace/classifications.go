@@ -1,66 +1,213 @@
 package horserace
 
+import "fmt"
+
 // BETFAIR NOTE: In the case of Beginners Handicap Hurdle, Novices Handicap Chase etc,
 // disregard Novice, Beginners etc as using the three abbreviations will not fit.
 // Therefore Hcap Hrd, or Hcap Chs.
 
-// classToAbbrev is a map from Classification to Abbreviation
-var classToAbbrev = map[string]string{
-	"Listed Race":               "Listed",
-	"Group 1":                   "Grp1",
-	"Group 2":                   "Grp2",
-	"Group 3":                   "Grp3",
-	"Maiden Stakes":             "Mdn Stks",
-	"Selling Stakes":            "Sell Stks",
-	"Claiming Stakes":           "Claim Stks",
-	"Rated Stakes":              "Hcap",
-	"Classified Stakes":         "Class Stks",
-	"Nursery Handicap":          "Nursery",
-	"Handicap":                  "Hcap",
-	"Showcase Handicap":         "Hcap",
-	"Maiden":                    "Mdn",
-	"Stakes":                    "Stks",
-	"Hurdle":                    "Hrd",
-	"Chase":                     "Chs",
-	"Hunters":                   "Hunt",
-	"Novice":                    "Nov",
-	"Selling":                   "Sell",
-	"Claiming":                  "Claim",
-	"Classified":                "Class",
-	"Beginners":                 "Beg",
-	"National Hunt Flat":        "NHF",
-	"Irish NH Flat":             "INHF",
-	"Flat":                      "Flat",
-	"Beginners Handicap Hurdle": "Hcap Hrd",
-	"Novices Handicap Chase":    "Hcap Chs",
-	"(Non Of the Above)":        "Stks",
+// Classification represents a race classification.
+type Classification uint
+
+const (
+	// Classification_Handicap represents a handicap race.
+	Classification_Handicap = iota + 1
+	// Classification_Novice represents a race for novice horses.
+	Classification_Novice
+	// Classification_Maiden represents a race for horses that have not won a race yet.
+	Classification_Maiden
+	// Classification_Group1 represents a Group 1 race.
+	Classification_Group1
+	// Classification_Group2 represents a Group 2 race.
+	Classification_Group2
+	// Classification_Group3 represents a Group 3 race.
+	Classification_Group3
+	// Classification_Listed represents a listed race.
+	Classification_Listed
+	// Classification_Stakes represents a stakes race.
+	Classification_Stakes
+	// Classification_Selling represents a selling race.
+	Classification_Selling
+	// Classification_Claiming represents a claiming race.
+	Classification_Claiming
+	// Classification_Classified represents a classified race.
+	Classification_Classified
+	// Classification_Nursery represents a nursery handicap, for two-year-old horses.
+	Classification_Nursery
+	// Classification_Hunters represents a race for hunters.
+	Classification_Hunters
+	// Classification_Beginners represents a race for horses that have not won a chase yet.
+	Classification_Beginners
+	// Classification_MaidenStakes represents a maiden stakes race.
+	Classification_MaidenStakes
+	// Classification_SellingStakes represents a selling stakes race.
+	Classification_SellingStakes
+	// Classification_ClaimingStakes represents a claiming stakes race.
+	Classification_ClaimingStakes
+	// Classification_ShowcaseHandicap represents a showcase handicap.
+	Classification_ShowcaseHandicap
+	// Classification_RatedStakes represents a rated stakes race.
+	Classification_RatedStakes
+	// Classification_ClassifiedStakes represents a classified stakes race.
+	Classification_ClassifiedStakes
+	// Classification_Hurdle represents a hurdle race.
+	Classification_Hurdle
+	// Classification_Chase represents a steeplechase.
+	Classification_Chase
+	// Classification_NationalHuntFlat represents a national hunt flat race.
+	Classification_NationalHuntFlat
+	// Classification_IrishNHFlat represents an Irish national hunt flat race.
+	Classification_IrishNHFlat
+	// Classification_Flat represents a flat race.
+	Classification_Flat
+	// Classification_BeginnersHandicapHurdle represents a beginners handicap hurdle.
+	Classification_BeginnersHandicapHurdle
+	// Classification_NovicesHandicapChase represents a novices handicap chase.
+	Classification_NovicesHandicapChase
+	// Classification_NonOfTheAbove represents a race that doesn't fit any other classification.
+	Classification_NonOfTheAbove
+)
+
+// classificationInfo holds the name, betfair abbreviation and discipline of a Classification.
+type classificationInfo struct {
+	name       string
+	abbrev     string
+	discipline Discipline
+}
+
+// classifications holds the details of every Classification, indexed by Classification.
+// When several classifications share the same abbreviation, the first one is the most common.
+// The abbreviations are the embedded defaults of the registry, which may override them.
+var classifications = [...]classificationInfo{
+	{},
+	{name: "Handicap", abbrev: "Hcap", discipline: Discipline_Both},
+	{name: "Novice", abbrev: "Nov", discipline: Discipline_Both},
+	{name: "Maiden", abbrev: "Mdn", discipline: Discipline_Both},
+	{name: "Group 1", abbrev: "Grp1", discipline: Discipline_Flat},
+	{name: "Group 2", abbrev: "Grp2", discipline: Discipline_Flat},
+	{name: "Group 3", abbrev: "Grp3", discipline: Discipline_Flat},
+	{name: "Listed Race", abbrev: "Listed", discipline: Discipline_Both},
+	{name: "Stakes", abbrev: "Stks", discipline: Discipline_Flat},
+	{name: "Selling", abbrev: "Sell", discipline: Discipline_Both},
+	{name: "Claiming", abbrev: "Claim", discipline: Discipline_Both},
+	{name: "Classified", abbrev: "Class", discipline: Discipline_Flat},
+	{name: "Nursery Handicap", abbrev: "Nursery", discipline: Discipline_Flat},
+	{name: "Hunters", abbrev: "Hunt", discipline: Discipline_Jumps},
+	{name: "Beginners", abbrev: "Beg", discipline: Discipline_Jumps},
+	{name: "Maiden Stakes", abbrev: "Mdn Stks", discipline: Discipline_Flat},
+	{name: "Selling Stakes", abbrev: "Sell Stks", discipline: Discipline_Flat},
+	{name: "Claiming Stakes", abbrev: "Claim Stks", discipline: Discipline_Flat},
+	{name: "Showcase Handicap", abbrev: "Hcap", discipline: Discipline_Flat},
+	{name: "Rated Stakes", abbrev: "Hcap", discipline: Discipline_Flat},
+	{name: "Classified Stakes", abbrev: "Class Stks", discipline: Discipline_Flat},
+	{name: "Hurdle", abbrev: "Hrd", discipline: Discipline_Jumps},
+	{name: "Chase", abbrev: "Chs", discipline: Discipline_Jumps},
+	{name: "National Hunt Flat", abbrev: "NHF", discipline: Discipline_Jumps},
+	{name: "Irish NH Flat", abbrev: "INHF", discipline: Discipline_Jumps},
+	{name: "Flat", abbrev: "Flat", discipline: Discipline_Flat},
+	{name: "Beginners Handicap Hurdle", abbrev: "Hcap Hrd", discipline: Discipline_Jumps},
+	{name: "Novices Handicap Chase", abbrev: "Hcap Chs", discipline: Discipline_Jumps},
+	{name: "(Non Of the Above)", abbrev: "Stks", discipline: Discipline_Both},
+}
+
+// String returns the string representation of Classification.
+func (c Classification) String() string {
+	return classifications[c].name
 }
 
-// abbrevToClass is a map from Abbreviation to Classification
-var abbrevToClass = map[string][]string{
-	"Listed":     {"Listed Race"},
-	"Grp1":       {"Group 1"},
-	"Grp2":       {"Group 2"},
-	"Grp3":       {"Group 3"},
-	"Mdn Stks":   {"Maiden Stakes"},
-	"Sell Stks":  {"Selling Stakes"},
-	"Claim Stks": {"Claiming Stakes"},
-	"Hcap":       {"Handicap", "Showcase Handicap", "Rated Stakes"},
-	"Class Stks": {"Classified Stakes"},
-	"Nursery":    {"Nursery Handicap"},
-	"Mdn":        {"Maiden"},
-	"Hrd":        {"Hurdle"},
-	"Chs":        {"Chase"},
-	"Hunt":       {"Hunters"},
-	"Nov":        {"Novice"},
-	"Sell":       {"Selling"},
-	"Claim":      {"Claiming"},
-	"Class":      {"Classified"},
-	"Beg":        {"Beginners"},
-	"NHF":        {"National Hunt Flat"},
-	"INHF":       {"Irish NH Flat"},
-	"Flat":       {"Flat"},
-	"Hcap Hrd":   {"Beginners Handicap Hurdle"},
-	"Hcap Chs":   {"Novices Handicap Chase"},
-	"Stks":       {"Stakes", "(Non Of the Above)"},
+// Abbrev returns the betfair abbreviation of Classification in the default registry.
+// An empty string is returned if the classification was removed from the default registry.
+func (c Classification) Abbrev() string {
+	abbrev, err := defaultRegistry.ClassificationAbbrev(c.String())
+	if err != nil {
+		return ""
+	}
+	return abbrev
+}
+
+// Discipline returns whether Classification is used in flat races, jump races or both.
+func (c Classification) Discipline() Discipline {
+	return classifications[c].discipline
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (c Classification) MarshalText() ([]byte, error) {
+	if c == 0 || int(c) >= len(classifications) {
+		return nil, fmt.Errorf("unknown classification [%d]", c)
+	}
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (c *Classification) UnmarshalText(text []byte) error {
+	class, err := ParseClassification(string(text))
+	if err != nil {
+		return err
+	}
+	*c = class
+	return nil
+}
+
+// ParseClassification returns the Classification, given its string representation.
+func ParseClassification(s string) (Classification, error) {
+	for i, info := range classifications[1:] {
+		if info.name == s {
+			return Classification(i + 1), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown classification [%s]", s)
+}
+
+// ParseClassificationAbbrev returns all classifications that share the given betfair abbreviation
+// in the default registry, starting with the most common one. See Registry.ParseClassificationAbbrev.
+func ParseClassificationAbbrev(abbrev string) ([]Classification, error) {
+	return defaultRegistry.ParseClassificationAbbrev(abbrev)
+}
+
+// ClassificationsByDiscipline returns all classifications used in the given discipline.
+// Classifications used in both flat and jump races are included for Discipline_Flat and Discipline_Jumps.
+func ClassificationsByDiscipline(discipline Discipline) []Classification {
+	classes := []Classification{}
+	for i, info := range classifications[1:] {
+		if info.discipline == discipline || info.discipline == Discipline_Both {
+			classes = append(classes, Classification(i+1))
+		}
+	}
+	return classes
+}
+
+// Discipline represents whether a race is run on the flat or over jumps.
+type Discipline uint
+
+const (
+	// Discipline_Flat represents flat racing.
+	Discipline_Flat = iota + 1
+	// Discipline_Jumps represents jump racing, also known as national hunt.
+	Discipline_Jumps
+	// Discipline_Both represents both flat and jump racing.
+	Discipline_Both
+)
+
+// String returns the string representation of Discipline.
+func (d Discipline) String() string {
+	return [...]string{"", "Flat", "Jumps", "Both"}[d]
+}
+
+// RaceType represents the type of race.
+type RaceType uint
+
+const (
+	// RaceType_Flat represents a flat race.
+	RaceType_Flat = iota + 1
+	// RaceType_Hurdle represents a hurdle race.
+	RaceType_Hurdle
+	// RaceType_Chase represents a steeplechase.
+	RaceType_Chase
+	// RaceType_NHF represents a national hunt flat race, also known as bumper.
+	RaceType_NHF
+)
+
+// String returns the string representation of RaceType.
+func (rt RaceType) String() string {
+	return [...]string{"", "Flat", "Hurdle", "Chase", "NHF"}[rt]
 }
@@ -0,0 +1,100 @@
+package horserace_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/horserace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClassificationAbbrev(t *testing.T) {
+	tests := map[string]struct {
+		abbrev          string
+		expectedClasses []horserace.Classification
+		expectedErr     bool
+	}{
+		"abbrev: <Empty>": {abbrev: "", expectedErr: true},
+		"abbrev: Grp1":    {abbrev: "Grp1", expectedClasses: []horserace.Classification{horserace.Classification_Group1}},
+		"abbrev: Hcap": {abbrev: "Hcap", expectedClasses: []horserace.Classification{
+			horserace.Classification_Handicap, horserace.Classification_ShowcaseHandicap, horserace.Classification_RatedStakes}},
+		"abbrev: Stks": {abbrev: "Stks", expectedClasses: []horserace.Classification{
+			horserace.Classification_Stakes, horserace.Classification_NonOfTheAbove}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			classes, err := horserace.ParseClassificationAbbrev(test.abbrev)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.Equal(t, test.expectedClasses, classes)
+		})
+	}
+}
+
+func TestClassificationMethods(t *testing.T) {
+	tests := map[string]struct {
+		class              horserace.Classification
+		expectedString     string
+		expectedAbbrev     string
+		expectedDiscipline horserace.Discipline
+	}{
+		"class: Listed":           {class: horserace.Classification_Listed, expectedString: "Listed Race", expectedAbbrev: "Listed", expectedDiscipline: horserace.Discipline_Both},
+		"class: Maiden Stakes":    {class: horserace.Classification_MaidenStakes, expectedString: "Maiden Stakes", expectedAbbrev: "Mdn Stks", expectedDiscipline: horserace.Discipline_Flat},
+		"class: Novices Hcap Chs": {class: horserace.Classification_NovicesHandicapChase, expectedString: "Novices Handicap Chase", expectedAbbrev: "Hcap Chs", expectedDiscipline: horserace.Discipline_Jumps},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expectedString, test.class.String())
+			assert.Equal(t, test.expectedAbbrev, test.class.Abbrev())
+			assert.Equal(t, test.expectedDiscipline, test.class.Discipline())
+
+			class, err := horserace.ParseClassification(test.expectedString)
+			require.NoError(t, err)
+			assert.Equal(t, test.class, class)
+		})
+	}
+}
+
+func TestClassificationJSON(t *testing.T) {
+	type race struct {
+		Classes []horserace.Classification `json:"classes"`
+	}
+
+	data, err := json.Marshal(race{Classes: []horserace.Classification{horserace.Classification_Group1, horserace.Classification_Handicap}})
+	require.NoError(t, err)
+	assert.Equal(t, `{"classes":["Group 1","Handicap"]}`, string(data))
+
+	r := race{}
+	err = json.Unmarshal(data, &r)
+	require.NoError(t, err)
+	assert.Equal(t, []horserace.Classification{horserace.Classification_Group1, horserace.Classification_Handicap}, r.Classes)
+
+	err = json.Unmarshal([]byte(`{"classes":["Group 4"]}`), &r)
+	assert.Error(t, err)
+
+	_, err = json.Marshal(race{Classes: []horserace.Classification{0}})
+	assert.Error(t, err)
+}
+
+func TestClassificationsByDiscipline(t *testing.T) {
+	flat := horserace.ClassificationsByDiscipline(horserace.Discipline_Flat)
+	jumps := horserace.ClassificationsByDiscipline(horserace.Discipline_Jumps)
+
+	assert.Contains(t, flat, horserace.Classification(horserace.Classification_Group1))
+	assert.NotContains(t, jumps, horserace.Classification(horserace.Classification_Group1))
+	assert.Contains(t, jumps, horserace.Classification(horserace.Classification_Chase))
+	assert.NotContains(t, flat, horserace.Classification(horserace.Classification_Chase))
+	assert.Contains(t, flat, horserace.Classification(horserace.Classification_Handicap))
+	assert.Contains(t, jumps, horserace.Classification(horserace.Classification_Handicap))
+}
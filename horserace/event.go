@@ -0,0 +1,235 @@
+package horserace
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EventName represents the parsed name of a horse race event.
+type EventName struct {
+	// Betfair abbreviation of the track.
+	Abbrev string
+	// Country in the event name, e.g. "(US)". Zero if the event name doesn't have one.
+	Country Country
+	// Day of the month of the meeting.
+	Day int
+	// Month of the meeting.
+	Month time.Month
+}
+
+// ParseEventName parses the name of a horse race event (as returned by the betfair API), e.g. "Kemp 5th Mar".
+// The last two words must be the day of the month, with or without an ordinal suffix, and the month.
+// The track abbreviation may be followed by the country code in parentheses, e.g. "Gulf (US) 5th Mar".
+func ParseEventName(eventName string) (en EventName, err error) {
+	words := strings.Fields(eventName)
+	if len(words) < 3 {
+		return en, fmt.Errorf("event name [%s] must have a track, a day and a month", eventName)
+	}
+
+	day, err := parseDayOfMonth(words[len(words)-2])
+	if err != nil {
+		return en, err
+	}
+
+	month, err := parseMonth(words[len(words)-1])
+	if err != nil {
+		return en, err
+	}
+
+	words = words[:len(words)-2]
+	if last := words[len(words)-1]; len(words) > 1 && strings.HasPrefix(last, "(") && strings.HasSuffix(last, ")") {
+		country, err := ParseCountryCode(strings.Trim(last, "()"))
+		if err != nil {
+			return en, err
+		}
+		en.Country = country
+		words = words[:len(words)-1]
+	}
+
+	en.Abbrev = strings.Join(words, " ")
+	en.Day = day
+	en.Month = month
+	return en, nil
+}
+
+// parseDayOfMonth parses the day of the month, e.g. "5th" or "5".
+// The ordinal suffix, if present, must match the day, e.g. "5st" is rejected.
+func parseDayOfMonth(s string) (int, error) {
+	digits := strings.TrimRight(strings.ToLower(s), "stndrh")
+	suffix := strings.ToLower(s)[len(digits):]
+
+	day, err := strconv.Atoi(digits)
+	if err != nil || day < 1 || day > 31 {
+		return 0, fmt.Errorf("couldn't parse day of the month [%s]", s)
+	}
+
+	if suffix != "" && suffix != ordinalSuffix(day) {
+		return 0, fmt.Errorf("couldn't parse day of the month [%s]", s)
+	}
+	return day, nil
+}
+
+// ordinalSuffix returns the English ordinal suffix of the day of the month, e.g. "st" for 21.
+func ordinalSuffix(day int) string {
+	switch day {
+	case 1, 21, 31:
+		return "st"
+	case 2, 22:
+		return "nd"
+	case 3, 23:
+		return "rd"
+	}
+	return "th"
+}
+
+// parseMonth parses the month, either abbreviated or in full, e.g. "Mar" or "March".
+func parseMonth(s string) (time.Month, error) {
+	for m := time.January; m <= time.December; m++ {
+		if strings.EqualFold(s, m.String()) || strings.EqualFold(s, m.String()[:3]) {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("couldn't parse month [%s]", s)
+}
+
+// raceKeyTimeLayout is the layout of the start time in the string representation of RaceKey.
+const raceKeyTimeLayout = "2006-01-02T15:04Z"
+
+// RaceKey represents a canonical race identifier, shared by every source of race data.
+type RaceKey struct {
+	// Country of the track.
+	Country Country
+	// Name of the track.
+	Track string
+	// Betfair abbreviation of the track.
+	Abbrev string
+	// Start time of the race in UTC, truncated to the minute.
+	StartTime time.Time
+}
+
+// NewRaceKey returns the race key, given the country, the event name and the market start time
+// (as returned by the betfair API), using the default registry. See Registry.RaceKey.
+func NewRaceKey(country Country, eventName string, marketStartTime time.Time) (RaceKey, error) {
+	return defaultRegistry.RaceKey(country, eventName, marketStartTime)
+}
+
+// ParseRaceKey parses the string representation of RaceKey, using the default registry.
+func ParseRaceKey(s string) (RaceKey, error) {
+	return defaultRegistry.ParseRaceKey(s)
+}
+
+// RaceKey returns the race key, given the country, the event name and the market start time
+// (as returned by the betfair API).
+// The country may be zero if the event name has a country code, otherwise both must agree.
+// The track abbreviation is looked up exactly first and then with ResolveTrack.
+// An error is returned if the date in the event name is more than a day away from the market start time,
+// which allows for the time difference between the track and UTC.
+func (r *Registry) RaceKey(country Country, eventName string, marketStartTime time.Time) (rk RaceKey, err error) {
+	en, err := ParseEventName(eventName)
+	if err != nil {
+		return rk, err
+	}
+
+	if country == 0 {
+		country = en.Country
+	}
+
+	if country == 0 {
+		return rk, fmt.Errorf("event name [%s] doesn't have a country", eventName)
+	}
+
+	if en.Country != 0 && en.Country != country {
+		return rk, fmt.Errorf("event name [%s] is not in country [%s]", eventName, country)
+	}
+
+	if marketStartTime.IsZero() {
+		return rk, fmt.Errorf("market start time cannot be empty")
+	}
+
+	startTime := marketStartTime.UTC().Truncate(time.Minute)
+	if !eventDateMatches(en, startTime) {
+		return rk, fmt.Errorf("event name [%s] doesn't match market start time [%s]", eventName, startTime.Format(raceKeyTimeLayout))
+	}
+
+	return r.raceKey(country, en.Abbrev, startTime)
+}
+
+// ParseRaceKey parses the string representation of RaceKey.
+func (r *Registry) ParseRaceKey(s string) (rk RaceKey, err error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 3 {
+		return rk, fmt.Errorf("race key [%s] must have a country, a track and a start time", s)
+	}
+
+	country, err := ParseCountryCode(parts[0])
+	if err != nil {
+		return rk, err
+	}
+
+	startTime, err := time.Parse(raceKeyTimeLayout, parts[2])
+	if err != nil {
+		return rk, fmt.Errorf("couldn't parse start time [%s]: %w", parts[2], err)
+	}
+
+	return r.raceKey(country, parts[1], startTime)
+}
+
+// raceKey returns the race key with the track resolved from the abbreviation.
+func (r *Registry) raceKey(country Country, abbrev string, startTime time.Time) (rk RaceKey, err error) {
+	rk = RaceKey{Country: country, Abbrev: abbrev, StartTime: startTime}
+
+	if rk.Track, err = r.TrackName(country, abbrev); err == nil {
+		return rk, nil
+	}
+
+	matches, resolveErr := r.ResolveTrack(country, abbrev)
+	if resolveErr != nil || matches[0].Confidence < abbrevConfidence {
+		return RaceKey{}, err
+	}
+
+	rk.Track = matches[0].Name
+	rk.Abbrev = matches[0].Abbrev
+	return rk, nil
+}
+
+// eventDateMatches returns true if the event date is at most a day away from the start time,
+// trying the year before and after to cope with meetings around the new year.
+func eventDateMatches(en EventName, startTime time.Time) bool {
+	day := time.Date(startTime.Year(), startTime.Month(), startTime.Day(), 0, 0, 0, 0, time.UTC)
+	for year := startTime.Year() - 1; year <= startTime.Year()+1; year++ {
+		date := time.Date(year, en.Month, en.Day, 0, 0, 0, 0, time.UTC)
+		if date.Month() != en.Month {
+			continue
+		}
+
+		if diff := date.Sub(day); diff >= -24*time.Hour && diff <= 24*time.Hour {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the string representation of RaceKey, e.g. "GB/Kemp/2021-03-05T14:30Z".
+func (rk RaceKey) String() string {
+	return rk.Country.ISOCode() + "/" + rk.Abbrev + "/" + rk.StartTime.UTC().Format(raceKeyTimeLayout)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (rk RaceKey) MarshalText() ([]byte, error) {
+	if rk.Country == 0 || rk.Abbrev == "" || rk.StartTime.IsZero() {
+		return nil, fmt.Errorf("race key is incomplete")
+	}
+	return []byte(rk.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, using the default registry.
+func (rk *RaceKey) UnmarshalText(text []byte) error {
+	key, err := ParseRaceKey(string(text))
+	if err != nil {
+		return err
+	}
+	*rk = key
+	return nil
+}
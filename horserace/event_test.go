@@ -0,0 +1,161 @@
+package horserace_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gustavooferreira/bfutils/horserace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEventName(t *testing.T) {
+	tests := map[string]struct {
+		eventName         string
+		expectedEventName horserace.EventName
+		expectedErr       bool
+	}{
+		"parse: abbreviation":  {eventName: "Kemp 5th Mar", expectedEventName: horserace.EventName{Abbrev: "Kemp", Day: 5, Month: time.March}},
+		"parse: 1st":           {eventName: "ChelmC 1st Jan", expectedEventName: horserace.EventName{Abbrev: "ChelmC", Day: 1, Month: time.January}},
+		"parse: 22nd":          {eventName: "Curr 22nd May", expectedEventName: horserace.EventName{Abbrev: "Curr", Day: 22, Month: time.May}},
+		"parse: 23rd":          {eventName: "Asc 23rd Jun", expectedEventName: horserace.EventName{Abbrev: "Asc", Day: 23, Month: time.June}},
+		"parse: 11th":          {eventName: "Kemp 11th Mar", expectedEventName: horserace.EventName{Abbrev: "Kemp", Day: 11, Month: time.March}},
+		"parse: no suffix":     {eventName: "Kemp 5 March", expectedEventName: horserace.EventName{Abbrev: "Kemp", Day: 5, Month: time.March}},
+		"parse: lower case":    {eventName: "Kemp 5TH mar", expectedEventName: horserace.EventName{Abbrev: "Kemp", Day: 5, Month: time.March}},
+		"parse: several words": {eventName: "Down Royal 31st Oct", expectedEventName: horserace.EventName{Abbrev: "Down Royal", Day: 31, Month: time.October}},
+		"parse: country":       {eventName: "Gulf (US) 5th Mar", expectedEventName: horserace.EventName{Abbrev: "Gulf", Country: horserace.Country_US, Day: 5, Month: time.March}},
+		"parse: bad country":   {eventName: "Gulf (XX) 5th Mar", expectedErr: true},
+		"parse: bad suffix":    {eventName: "Kemp 5xx Mar", expectedErr: true},
+		"parse: 5st":           {eventName: "Kemp 5st Mar", expectedErr: true},
+		"parse: 1th":           {eventName: "Kemp 1th Mar", expectedErr: true},
+		"parse: 2rd":           {eventName: "Kemp 2rd Mar", expectedErr: true},
+		"parse: 11st":          {eventName: "Kemp 11st Mar", expectedErr: true},
+		"parse: 22th":          {eventName: "Kemp 22th Mar", expectedErr: true},
+		"parse: bad day":       {eventName: "Kemp 32nd Mar", expectedErr: true},
+		"parse: zero day":      {eventName: "Kemp 0th Mar", expectedErr: true},
+		"parse: bad month":     {eventName: "Kemp 5th Mrz", expectedErr: true},
+		"parse: no track":      {eventName: "5th Mar", expectedErr: true},
+		"parse: empty":         {eventName: "", expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			value, err := horserace.ParseEventName(test.eventName)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assert.Equal(t, test.expectedEventName, value)
+		})
+	}
+}
+
+func TestNewRaceKey(t *testing.T) {
+	tests := map[string]struct {
+		country       horserace.Country
+		eventName     string
+		startTime     time.Time
+		expectedKey   string
+		expectedTrack string
+		expectedErr   bool
+	}{
+		"key: uk": {country: horserace.Country_UK, eventName: "Kemp 5th Mar",
+			startTime: time.Date(2021, time.March, 5, 14, 30, 45, 0, time.UTC), expectedKey: "GB/Kemp/2021-03-05T14:30Z", expectedTrack: "Kempton"},
+		"key: time zone": {country: horserace.Country_IRE, eventName: "DownR 5th Mar",
+			startTime: time.Date(2021, time.March, 5, 14, 30, 0, 0, time.FixedZone("CET", 3600)), expectedKey: "IE/DownR/2021-03-05T13:30Z", expectedTrack: "Down Royal"},
+		"key: country from event name": {eventName: "Ascot (GB) 19th Jun",
+			startTime: time.Date(2021, time.June, 19, 15, 0, 0, 0, time.UTC), expectedKey: "GB/Ascot/2021-06-19T15:00Z", expectedTrack: "Ascot"},
+		"key: case insensitive abbreviation": {country: horserace.Country_UK, eventName: "kemp 5th Mar",
+			startTime: time.Date(2021, time.March, 5, 14, 30, 0, 0, time.UTC), expectedKey: "GB/Kemp/2021-03-05T14:30Z", expectedTrack: "Kempton"},
+		"key: day before in UTC": {country: horserace.Country_UK, eventName: "Kemp 1st Jan",
+			startTime: time.Date(2020, time.December, 31, 23, 30, 0, 0, time.UTC), expectedKey: "GB/Kemp/2020-12-31T23:30Z", expectedTrack: "Kempton"},
+		"key: wrong date": {country: horserace.Country_UK, eventName: "Kemp 7th Mar",
+			startTime: time.Date(2021, time.March, 5, 14, 30, 0, 0, time.UTC), expectedErr: true},
+		"key: unknown track": {country: horserace.Country_UK, eventName: "Flem 5th Mar",
+			startTime: time.Date(2021, time.March, 5, 14, 30, 0, 0, time.UTC), expectedErr: true},
+		"key: countries differ": {country: horserace.Country_IRE, eventName: "Kemp (GB) 5th Mar",
+			startTime: time.Date(2021, time.March, 5, 14, 30, 0, 0, time.UTC), expectedErr: true},
+		"key: no country": {eventName: "Kemp 5th Mar",
+			startTime: time.Date(2021, time.March, 5, 14, 30, 0, 0, time.UTC), expectedErr: true},
+		"key: no start time": {country: horserace.Country_UK, eventName: "Kemp 5th Mar", expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			key, err := horserace.NewRaceKey(test.country, test.eventName, test.startTime)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assert.Equal(t, test.expectedKey, key.String())
+			assert.Equal(t, test.expectedTrack, key.Track)
+
+			parsed, err := horserace.ParseRaceKey(key.String())
+			require.NoError(t, err)
+			assert.Equal(t, key, parsed)
+		})
+	}
+}
+
+func TestParseRaceKey(t *testing.T) {
+	tests := map[string]struct {
+		raceKey     string
+		expectedErr bool
+	}{
+		"parse: valid":           {raceKey: "GB/Kemp/2021-03-05T14:30Z"},
+		"parse: missing part":    {raceKey: "GB/Kemp", expectedErr: true},
+		"parse: unknown country": {raceKey: "XX/Kemp/2021-03-05T14:30Z", expectedErr: true},
+		"parse: unknown track":   {raceKey: "GB/Flem/2021-03-05T14:30Z", expectedErr: true},
+		"parse: bad time":        {raceKey: "GB/Kemp/2021-03-05 14:30", expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			_, err := horserace.ParseRaceKey(test.raceKey)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+		})
+	}
+}
+
+func TestRaceKeyJSON(t *testing.T) {
+	key, err := horserace.NewRaceKey(horserace.Country_UK, "Kemp 5th Mar", time.Date(2021, time.March, 5, 14, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	data, err := json.Marshal(map[string]horserace.RaceKey{"race": key})
+	require.NoError(t, err)
+	assert.Equal(t, `{"race":"GB/Kemp/2021-03-05T14:30Z"}`, string(data))
+
+	var decoded map[string]horserace.RaceKey
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, key, decoded["race"])
+
+	_, err = json.Marshal(horserace.RaceKey{})
+	assert.Error(t, err)
+}
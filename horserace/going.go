@@ -0,0 +1,250 @@
+package horserace
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Going represents the condition of the ground of a racecourse.
+// Turf goings are ordered from the firmest to the softest, followed by the all-weather goings
+// ordered from the fastest to the slowest. The Irish goings are placed in between their closest
+// British equivalents.
+type Going uint
+
+const (
+	// Going_Hard represents hard ground.
+	Going_Hard = iota + 1
+	// Going_Firm represents firm ground.
+	Going_Firm
+	// Going_GoodToFirm represents good to firm ground.
+	Going_GoodToFirm
+	// Going_Good represents good ground.
+	Going_Good
+	// Going_GoodToYielding represents good to yielding ground, used in Ireland.
+	Going_GoodToYielding
+	// Going_GoodToSoft represents good to soft ground.
+	Going_GoodToSoft
+	// Going_Yielding represents yielding ground, used in Ireland.
+	Going_Yielding
+	// Going_YieldingToSoft represents yielding to soft ground, used in Ireland.
+	Going_YieldingToSoft
+	// Going_Soft represents soft ground.
+	Going_Soft
+	// Going_SoftToHeavy represents soft to heavy ground.
+	Going_SoftToHeavy
+	// Going_Heavy represents heavy ground.
+	Going_Heavy
+	// Going_Fast represents a fast all-weather surface.
+	Going_Fast
+	// Going_StandardToFast represents a standard to fast all-weather surface.
+	Going_StandardToFast
+	// Going_Standard represents a standard all-weather surface.
+	Going_Standard
+	// Going_StandardToSlow represents a standard to slow all-weather surface.
+	Going_StandardToSlow
+	// Going_Slow represents a slow all-weather surface.
+	Going_Slow
+)
+
+// goingInfo holds the name and abbreviation of a Going.
+type goingInfo struct {
+	name   string
+	abbrev string
+}
+
+// goings holds the details of every Going, indexed by Going.
+var goings = [...]goingInfo{
+	{},
+	{name: "Hard", abbrev: "Hd"},
+	{name: "Firm", abbrev: "F"},
+	{name: "Good to Firm", abbrev: "GF"},
+	{name: "Good", abbrev: "G"},
+	{name: "Good to Yielding", abbrev: "GY"},
+	{name: "Good to Soft", abbrev: "GS"},
+	{name: "Yielding", abbrev: "Y"},
+	{name: "Yielding to Soft", abbrev: "YS"},
+	{name: "Soft", abbrev: "S"},
+	{name: "Soft to Heavy", abbrev: "SH"},
+	{name: "Heavy", abbrev: "Hvy"},
+	{name: "Fast", abbrev: "Fst"},
+	{name: "Standard to Fast", abbrev: "Std/Fst"},
+	{name: "Standard", abbrev: "Std"},
+	{name: "Standard to Slow", abbrev: "Std/Slw"},
+	{name: "Slow", abbrev: "Slw"},
+}
+
+// goingAliases is a map from other abbreviations in common use, in lower case, to Going
+var goingAliases = map[string]Going{
+	"hrd":  Going_Hard,
+	"fm":   Going_Firm,
+	"g/f":  Going_GoodToFirm,
+	"gd":   Going_Good,
+	"g/y":  Going_GoodToYielding,
+	"g/s":  Going_GoodToSoft,
+	"yld":  Going_Yielding,
+	"y/s":  Going_YieldingToSoft,
+	"sft":  Going_Soft,
+	"s/h":  Going_SoftToHeavy,
+	"hy":   Going_Heavy,
+	"stdf": Going_StandardToFast,
+	"stds": Going_StandardToSlow,
+	"sl":   Going_Slow,
+}
+
+// String returns the string representation of Going.
+func (g Going) String() string {
+	return goings[g].name
+}
+
+// Abbrev returns the abbreviation of Going, e.g. "GS" for Good to Soft.
+func (g Going) Abbrev() string {
+	return goings[g].abbrev
+}
+
+// IsAllWeather returns true if the going is used to describe all-weather surfaces.
+func (g Going) IsAllWeather() bool {
+	return g >= Going_Fast
+}
+
+// ValidFor returns true if the going can be used to describe the given surface.
+// All-weather goings are only valid for all-weather surfaces, and turf goings for every other surface.
+func (g Going) ValidFor(surface Surface) bool {
+	return g.IsAllWeather() == surface.IsAllWeather()
+}
+
+// Compare returns -1 if g is firmer (or faster) than other, 1 if g is softer (or slower) than other and 0 if they are the same.
+// An error is returned if one going is used for turf and the other for all-weather surfaces.
+func (g Going) Compare(other Going) (int, error) {
+	if g.IsAllWeather() != other.IsAllWeather() {
+		return 0, fmt.Errorf("cannot compare turf and all-weather goings [%s] and [%s]", g, other)
+	}
+
+	switch {
+	case g < other:
+		return -1, nil
+	case g > other:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (g Going) MarshalText() ([]byte, error) {
+	if g == 0 || int(g) >= len(goings) {
+		return nil, fmt.Errorf("unknown going [%d]", g)
+	}
+	return []byte(g.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (g *Going) UnmarshalText(text []byte) error {
+	going, err := ParseGoing(string(text))
+	if err != nil {
+		return err
+	}
+	*g = going
+	return nil
+}
+
+// ParseGoing returns the Going, given its name or abbreviation, e.g. "Good to Soft" or "GS".
+// Names and abbreviations are case insensitive.
+func ParseGoing(s string) (Going, error) {
+	normalised := strings.Join(strings.Fields(strings.ReplaceAll(strings.ToLower(s), "-", " ")), " ")
+
+	for i, info := range goings[1:] {
+		if normalised == strings.ToLower(info.name) || normalised == strings.ToLower(info.abbrev) {
+			return Going(i + 1), nil
+		}
+	}
+
+	if going, ok := goingAliases[normalised]; ok {
+		return going, nil
+	}
+	return 0, fmt.Errorf("unknown going [%s]", s)
+}
+
+// GoingDescription represents an official going description, e.g. "Soft, Heavy in places".
+type GoingDescription struct {
+	// Going of most of the course.
+	Going Going
+	// Goings found in places, in the order they appear in the description.
+	InPlaces []Going
+}
+
+// ParseGoingDescription parses an official going description.
+// The going of most of the course may be followed by goings in places, separated by commas or in parentheses,
+// e.g. "Soft, Heavy in places" or "Good to Soft (Good in places, Soft in places)".
+// Anything after "in places" is ignored, e.g. "on the hurdles course", and so is "(watered)".
+// All goings must be either turf or all-weather goings.
+func ParseGoingDescription(description string) (gd GoingDescription, err error) {
+	parts := strings.FieldsFunc(description, func(r rune) bool {
+		return r == ',' || r == '(' || r == ')'
+	})
+
+	if len(parts) == 0 {
+		return gd, fmt.Errorf("going description is empty")
+	}
+
+	if gd.Going, err = ParseGoing(parts[0]); err != nil {
+		return GoingDescription{}, err
+	}
+
+	for _, part := range parts[1:] {
+		if part = strings.TrimSpace(part); part == "" || strings.EqualFold(part, "watered") {
+			continue
+		}
+
+		index := strings.Index(strings.ToLower(part), "in places")
+		if index < 0 {
+			return GoingDescription{}, fmt.Errorf("couldn't parse [%s] in going description [%s]", part, description)
+		}
+
+		going, err := ParseGoing(part[:index])
+		if err != nil {
+			return GoingDescription{}, err
+		}
+
+		if going.IsAllWeather() != gd.Going.IsAllWeather() {
+			return GoingDescription{}, fmt.Errorf("going description [%s] mixes turf and all-weather goings", description)
+		}
+		gd.InPlaces = append(gd.InPlaces, going)
+	}
+
+	return gd, nil
+}
+
+// Firmest returns the firmest (or fastest) going in the description.
+func (gd GoingDescription) Firmest() Going {
+	firmest := gd.Going
+	for _, going := range gd.InPlaces {
+		if going < firmest {
+			firmest = going
+		}
+	}
+	return firmest
+}
+
+// Softest returns the softest (or slowest) going in the description.
+func (gd GoingDescription) Softest() Going {
+	softest := gd.Going
+	for _, going := range gd.InPlaces {
+		if going > softest {
+			softest = going
+		}
+	}
+	return softest
+}
+
+// ValidFor returns true if every going in the description can be used to describe the given surface.
+func (gd GoingDescription) ValidFor(surface Surface) bool {
+	if !gd.Going.ValidFor(surface) {
+		return false
+	}
+
+	for _, going := range gd.InPlaces {
+		if !going.ValidFor(surface) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,197 @@
+package horserace_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/horserace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGoing(t *testing.T) {
+	tests := map[string]struct {
+		going         string
+		expectedGoing horserace.Going
+		expectedErr   bool
+	}{
+		"parse: name":               {going: "Good to Firm", expectedGoing: horserace.Going_GoodToFirm},
+		"parse: case insensitive":   {going: "GOOD TO soft", expectedGoing: horserace.Going_GoodToSoft},
+		"parse: hyphens":            {going: "Good-to-Yielding", expectedGoing: horserace.Going_GoodToYielding},
+		"parse: whitespace":         {going: "  Soft  to   Heavy ", expectedGoing: horserace.Going_SoftToHeavy},
+		"parse: abbreviation":       {going: "GS", expectedGoing: horserace.Going_GoodToSoft},
+		"parse: lower abbrev":       {going: "gs", expectedGoing: horserace.Going_GoodToSoft},
+		"parse: all-weather":        {going: "Standard to Slow", expectedGoing: horserace.Going_StandardToSlow},
+		"parse: all-weather abbrev": {going: "Std", expectedGoing: horserace.Going_Standard},
+		"parse: slash abbrev":       {going: "Std/Fst", expectedGoing: horserace.Going_StandardToFast},
+		"parse: alias":              {going: "Yld", expectedGoing: horserace.Going_Yielding},
+		"parse: unknown":            {going: "Muddy", expectedErr: true},
+		"parse: empty":              {going: "", expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			value, err := horserace.ParseGoing(test.going)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assert.Equal(t, test.expectedGoing, value)
+		})
+	}
+}
+
+func TestGoingRoundTrip(t *testing.T) {
+	for g := horserace.Going(horserace.Going_Hard); g <= horserace.Going_Slow; g++ {
+		fromName, err := horserace.ParseGoing(g.String())
+		require.NoError(t, err)
+		assert.Equal(t, g, fromName)
+
+		fromAbbrev, err := horserace.ParseGoing(g.Abbrev())
+		require.NoError(t, err)
+		assert.Equal(t, g, fromAbbrev)
+	}
+}
+
+func TestGoingCompare(t *testing.T) {
+	tests := map[string]struct {
+		going              horserace.Going
+		other              horserace.Going
+		expectedComparison int
+		expectedErr        bool
+	}{
+		"compare: firmer":               {going: horserace.Going_GoodToFirm, other: horserace.Going_Good, expectedComparison: -1},
+		"compare: softer":               {going: horserace.Going_Heavy, other: horserace.Going_Soft, expectedComparison: 1},
+		"compare: same":                 {going: horserace.Going_Good, other: horserace.Going_Good, expectedComparison: 0},
+		"compare: irish":                {going: horserace.Going_Yielding, other: horserace.Going_GoodToSoft, expectedComparison: 1},
+		"compare: faster":               {going: horserace.Going_StandardToFast, other: horserace.Going_StandardToSlow, expectedComparison: -1},
+		"compare: turf and all-weather": {going: horserace.Going_Good, other: horserace.Going_Standard, expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			value, err := test.going.Compare(test.other)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assert.Equal(t, test.expectedComparison, value)
+		})
+	}
+}
+
+func TestGoingValidFor(t *testing.T) {
+	tests := map[string]struct {
+		going         horserace.Going
+		surface       horserace.Surface
+		expectedValid bool
+	}{
+		"valid: turf going on turf":             {going: horserace.Going_Good, surface: horserace.Surface_Turf, expectedValid: true},
+		"valid: turf going on sand":             {going: horserace.Going_Good, surface: horserace.Surface_Sand, expectedValid: true},
+		"valid: turf going on polytrack":        {going: horserace.Going_Good, surface: horserace.Surface_Polytrack, expectedValid: false},
+		"valid: all-weather going on tapeta":    {going: horserace.Going_Standard, surface: horserace.Surface_Tapeta, expectedValid: true},
+		"valid: all-weather going on fibresand": {going: horserace.Going_Slow, surface: horserace.Surface_Fibresand, expectedValid: true},
+		"valid: all-weather going on turf":      {going: horserace.Going_Standard, surface: horserace.Surface_Turf, expectedValid: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expectedValid, test.going.ValidFor(test.surface))
+		})
+	}
+}
+
+func TestParseGoingDescription(t *testing.T) {
+	tests := map[string]struct {
+		description         string
+		expectedDescription horserace.GoingDescription
+		expectedFirmest     horserace.Going
+		expectedSoftest     horserace.Going
+		expectedErr         bool
+	}{
+		"parse: single": {description: "Good to Firm",
+			expectedDescription: horserace.GoingDescription{Going: horserace.Going_GoodToFirm},
+			expectedFirmest:     horserace.Going_GoodToFirm, expectedSoftest: horserace.Going_GoodToFirm},
+		"parse: comma": {description: "Soft, Heavy in places",
+			expectedDescription: horserace.GoingDescription{Going: horserace.Going_Soft, InPlaces: []horserace.Going{horserace.Going_Heavy}},
+			expectedFirmest:     horserace.Going_Soft, expectedSoftest: horserace.Going_Heavy},
+		"parse: parentheses": {description: "Good to Soft (Good in places, Soft in places)",
+			expectedDescription: horserace.GoingDescription{Going: horserace.Going_GoodToSoft, InPlaces: []horserace.Going{horserace.Going_Good, horserace.Going_Soft}},
+			expectedFirmest:     horserace.Going_Good, expectedSoftest: horserace.Going_Soft},
+		"parse: qualifier": {description: "Good, Good to Firm in places on the hurdles course",
+			expectedDescription: horserace.GoingDescription{Going: horserace.Going_Good, InPlaces: []horserace.Going{horserace.Going_GoodToFirm}},
+			expectedFirmest:     horserace.Going_GoodToFirm, expectedSoftest: horserace.Going_Good},
+		"parse: watered": {description: "Good to Firm (watered)",
+			expectedDescription: horserace.GoingDescription{Going: horserace.Going_GoodToFirm},
+			expectedFirmest:     horserace.Going_GoodToFirm, expectedSoftest: horserace.Going_GoodToFirm},
+		"parse: all-weather": {description: "Standard to Slow",
+			expectedDescription: horserace.GoingDescription{Going: horserace.Going_StandardToSlow},
+			expectedFirmest:     horserace.Going_StandardToSlow, expectedSoftest: horserace.Going_StandardToSlow},
+		"parse: mixed":         {description: "Standard, Soft in places", expectedErr: true},
+		"parse: not in places": {description: "Good, Soft", expectedErr: true},
+		"parse: unknown going": {description: "Good, Muddy in places", expectedErr: true},
+		"parse: empty":         {description: " ", expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			value, err := horserace.ParseGoingDescription(test.description)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assert.Equal(t, test.expectedDescription, value)
+			assert.Equal(t, test.expectedFirmest, value.Firmest())
+			assert.Equal(t, test.expectedSoftest, value.Softest())
+		})
+	}
+}
+
+func TestGoingDescriptionValidFor(t *testing.T) {
+	gd, err := horserace.ParseGoingDescription("Soft, Heavy in places")
+	require.NoError(t, err)
+
+	assert.True(t, gd.ValidFor(horserace.Surface_Turf))
+	assert.False(t, gd.ValidFor(horserace.Surface_Polytrack))
+}
+
+func TestGoingJSON(t *testing.T) {
+	data, err := json.Marshal([]horserace.Going{horserace.Going_GoodToSoft, horserace.Going_Standard})
+	require.NoError(t, err)
+	assert.Equal(t, `["Good to Soft","Standard"]`, string(data))
+
+	var goings []horserace.Going
+	require.NoError(t, json.Unmarshal([]byte(`["GS","Std/Slw"]`), &goings))
+	assert.Equal(t, []horserace.Going{horserace.Going_GoodToSoft, horserace.Going_StandardToSlow}, goings)
+
+	_, err = json.Marshal(horserace.Going(0))
+	assert.Error(t, err)
+}
@@ -21,56 +21,47 @@ func GetClassAndDistance(marketName string) (name string, distance string) {
 
 // GetTrackNameFromAbbrev returns the track name, given the the betfair track abbreviation and country.
 func GetTrackNameFromAbbrev(country Country, abbrev string) (track string, err error) {
-	var ok bool
-
-	switch country {
-	case Country_UK:
-		track, ok = ukAbbrevToTrack[abbrev]
-	case Country_IRE:
-		track, ok = ireAbbrevToTrack[abbrev]
-	default:
-		return "", fmt.Errorf("country [%s] not supported", country)
-	}
-
-	if ok {
-		return track, nil
-	}
-	return "", fmt.Errorf("couldn't find track with abbreviation [%s] in country [%s]", abbrev, country)
+	return defaultRegistry.TrackName(country, abbrev)
 }
 
 // GetAbbrevFromTrackName returns the betfair track abbreviation, given the country and track name.
 func GetAbbrevFromTrackName(country Country, track string) (abbrev string, err error) {
-	var ok bool
-
-	switch country {
-	case Country_UK:
-		track, ok = ukTrackToAbbrev[track]
-	case Country_IRE:
-		track, ok = ireTrackToAbbrev[track]
-	default:
-		return "", fmt.Errorf("country [%s] not supported", country)
+	return defaultRegistry.TrackAbbrev(country, track)
+}
+
+// GetTrackNameFromCountryCode returns the track name, given the ISO country code (as returned by the
+// betfair API in countryCode) and the betfair track abbreviation.
+func GetTrackNameFromCountryCode(countryCode string, abbrev string) (track string, err error) {
+	country, err := ParseCountryCode(countryCode)
+	if err != nil {
+		return "", err
 	}
+	return GetTrackNameFromAbbrev(country, abbrev)
+}
 
-	if ok {
-		return track, nil
+// GetAbbrevFromTrackNameAndCountryCode returns the betfair track abbreviation, given the ISO country
+// code (as returned by the betfair API in countryCode) and the track name.
+func GetAbbrevFromTrackNameAndCountryCode(countryCode string, track string) (abbrev string, err error) {
+	country, err := ParseCountryCode(countryCode)
+	if err != nil {
+		return "", err
 	}
-	return "", fmt.Errorf("couldn't find track [%s] in country [%s]", abbrev, country)
+	return GetAbbrevFromTrackName(country, track)
 }
 
 // GetClassificationFromAbbrev returns the race classification, given the betfair classification abbreviation.
+// If several classifications share the abbreviation, only the most common one is returned, see ParseClassificationAbbrev.
 func GetClassificationFromAbbrev(abbrev string) (class string, err error) {
-	if classes, ok := abbrevToClass[abbrev]; ok {
-		return classes[0], nil
+	classes, err := defaultRegistry.Classifications(abbrev)
+	if err != nil {
+		return "", err
 	}
-	return "", fmt.Errorf("couldn't find match")
+	return classes[0], nil
 }
 
 // GetAbbrevFromClassification returns the betfair classification abbreviation, given the race classification.
 func GetAbbrevFromClassification(class string) (abbrev string, err error) {
-	if abbrev, ok := classToAbbrev[class]; ok {
-		return abbrev, nil
-	}
-	return "", fmt.Errorf("couldn't find match")
+	return defaultRegistry.ClassificationAbbrev(class)
 }
 
 // Country represents a country.
@@ -81,9 +72,42 @@ const (
 	Country_UK = iota + 1
 	// Country_IRE represents IRE country
 	Country_IRE
+	// Country_FR represents FR country
+	Country_FR
+	// Country_US represents US country
+	Country_US
+	// Country_AUS represents AUS country
+	Country_AUS
+	// Country_RSA represents RSA country
+	Country_RSA
+	// Country_UAE represents UAE country
+	Country_UAE
 )
 
 // String returns the string representation of Country.
 func (c Country) String() string {
-	return [...]string{"", "UK", "IRE"}[c]
+	return [...]string{"", "UK", "IRE", "FR", "US", "AUS", "RSA", "UAE"}[c]
+}
+
+// ISOCode returns the ISO 3166-1 alpha-2 code of Country, as used by the betfair API.
+func (c Country) ISOCode() string {
+	return [...]string{"", "GB", "IE", "FR", "US", "AU", "ZA", "AE"}[c]
+}
+
+// ParseCountryCode returns the Country, given the ISO 3166-1 alpha-2 country code (as returned by
+// the betfair API in countryCode). The code is case insensitive.
+func ParseCountryCode(code string) (Country, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	// UK is not an ISO code, but it's commonly used instead of GB
+	if code == "UK" {
+		return Country_UK, nil
+	}
+
+	for c := Country(Country_UK); c <= Country_UAE; c++ {
+		if c.ISOCode() == code {
+			return c, nil
+		}
+	}
+	return 0, fmt.Errorf("country code [%s] not supported", code)
 }
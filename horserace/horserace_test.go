@@ -43,6 +43,8 @@ func TestGetTrackNameFromAbbrev(t *testing.T) {
 		"race: UK, no abbrev":            {country: horserace.Country_UK, abbrev: "NoTrack", expectedErr: true},
 		"race: UK, Leicester":            {country: horserace.Country_UK, abbrev: "Leic", expectedTrack: "Leicester"},
 		"race: IRE, Clonmel":             {country: horserace.Country_IRE, abbrev: "Clon", expectedTrack: "Clonmel"},
+		"race: FR, Chantilly":            {country: horserace.Country_FR, abbrev: "Chant", expectedTrack: "Chantilly"},
+		"race: AUS, Flemington":          {country: horserace.Country_AUS, abbrev: "Flem", expectedTrack: "Flemington"},
 	}
 
 	for name, test := range tests {
@@ -73,6 +75,7 @@ func TestGetAbbrevFromTrackName(t *testing.T) {
 		"race: UK, no track":            {country: horserace.Country_UK, track: "NoTrack", expectedErr: true},
 		"race: UK, Leicester":           {country: horserace.Country_UK, track: "Leicester", expectedAbbrev: "Leic"},
 		"race: IRE, Clonmel":            {country: horserace.Country_IRE, track: "Clonmel", expectedAbbrev: "Clon"},
+		"race: UAE, Meydan":             {country: horserace.Country_UAE, track: "Meydan", expectedAbbrev: "Meyd"},
 	}
 
 	for name, test := range tests {
@@ -147,3 +150,79 @@ func TestGetAbbrevFromClassification(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCountryCode(t *testing.T) {
+	tests := map[string]struct {
+		code            string
+		expectedCountry horserace.Country
+		expectedErr     bool
+	}{
+		"country code: <Empty>": {code: "", expectedErr: true},
+		"country code: DE":      {code: "DE", expectedErr: true},
+		"country code: GB":      {code: "GB", expectedCountry: horserace.Country_UK},
+		"country code: UK":      {code: "UK", expectedCountry: horserace.Country_UK},
+		"country code: ie":      {code: "ie", expectedCountry: horserace.Country_IRE},
+		"country code: ZA":      {code: "ZA", expectedCountry: horserace.Country_RSA},
+		"country code: AE":      {code: "AE", expectedCountry: horserace.Country_UAE},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			country, err := horserace.ParseCountryCode(test.code)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.Equal(t, test.expectedCountry, country)
+		})
+	}
+}
+
+func TestGetTrackNameFromCountryCode(t *testing.T) {
+	tests := map[string]struct {
+		countryCode   string
+		abbrev        string
+		expectedTrack string
+		expectedErr   bool
+	}{
+		"race: DE, no abbrev":      {countryCode: "DE", abbrev: "Koln", expectedErr: true},
+		"race: US, no abbrev":      {countryCode: "US", abbrev: "NoTrack", expectedErr: true},
+		"race: GB, Kempton":        {countryCode: "GB", abbrev: "Kemp", expectedTrack: "Kempton"},
+		"race: US, Santa Anita":    {countryCode: "US", abbrev: "SAnita", expectedTrack: "Santa Anita"},
+		"race: ZA, Turffontein":    {countryCode: "ZA", abbrev: "Turff", expectedTrack: "Turffontein"},
+		"race: AU, Moonee Valley":  {countryCode: "AU", abbrev: "MooV", expectedTrack: "Moonee Valley"},
+		"race: FR, Saint-Cloud":    {countryCode: "FR", abbrev: "StCl", expectedTrack: "Saint-Cloud"},
+		"race: AE, Abu Dhabi":      {countryCode: "AE", abbrev: "AbuD", expectedTrack: "Abu Dhabi"},
+		"race: IE, The Curragh":    {countryCode: "IE", abbrev: "Curr", expectedTrack: "The Curragh"},
+		"race: <Empty>, no abbrev": {expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			track, err := horserace.GetTrackNameFromCountryCode(test.countryCode, test.abbrev)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.Equal(t, test.expectedTrack, track)
+
+			if test.expectedErr {
+				return
+			}
+
+			abbrev, err := horserace.GetAbbrevFromTrackNameAndCountryCode(test.countryCode, track)
+			require.NoError(t, err)
+			assert.Equal(t, test.abbrev, abbrev)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package horserace
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gustavooferreira/bfutils/conversion"
+)
+
+// MarketName represents the parsed name of a horse race market.
+type MarketName struct {
+	// Distance of the race.
+	Distance conversion.Distance
+	// Type of the race.
+	RaceType RaceType
+	// Classifications of the race, in the order they appear in the name.
+	Classifications []Classification
+	// Words in the name that could not be parsed.
+	UnknownTokens []string
+}
+
+// tokenToRaceType is a map from the abbreviations used in market names to RaceType
+var tokenToRaceType = map[string]RaceType{
+	"Flat": RaceType_Flat,
+	"Hrd":  RaceType_Hurdle,
+	"Chs":  RaceType_Chase,
+	"NHF":  RaceType_NHF,
+	"INHF": RaceType_NHF,
+}
+
+// ParseMarketName parses the name of a horse race market (as returned by the betfair API), e.g. "2m4f Hcap Chs",
+// using the classification abbreviations of the default registry. See Registry.ParseMarketName.
+func ParseMarketName(marketName string) (mn MarketName, err error) {
+	return defaultRegistry.ParseMarketName(marketName)
+}
+
+// ParseMarketName parses the name of a horse race market (as returned by the betfair API), e.g. "2m4f Hcap Chs".
+// The first word must be the distance. Races without a hurdle, chase or NHF abbreviation are flat races.
+// Other words are looked up as classification abbreviations, trying two words at a time first, e.g. "Mdn Stks",
+// and taking the most common classification when several share the same abbreviation.
+// Race type abbreviations take precedence, so "Hcap Chs" is a handicap chase rather than "Novices Handicap Chase".
+// Words that are not known abbreviations are returned in UnknownTokens instead of failing the parsing.
+func (r *Registry) ParseMarketName(marketName string) (mn MarketName, err error) {
+	words := strings.Fields(marketName)
+	if len(words) == 0 {
+		return mn, fmt.Errorf("market name is empty")
+	}
+
+	distance, err := conversion.ParseDistance(words[0])
+	if err != nil {
+		return mn, fmt.Errorf("couldn't parse distance [%s]: %w", words[0], err)
+	}
+
+	mn.Distance = distance
+
+	words = words[1:]
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+
+		if raceType, ok := tokenToRaceType[word]; ok {
+			if mn.RaceType != 0 && mn.RaceType != raceType {
+				return MarketName{}, fmt.Errorf("market name [%s] has more than one race type", marketName)
+			}
+			mn.RaceType = raceType
+			continue
+		}
+
+		// Two-word abbreviations, e.g. "Mdn Stks", take precedence over single words
+		if i+1 < len(words) {
+			if _, ok := tokenToRaceType[words[i+1]]; !ok {
+				if classes, err := r.ParseClassificationAbbrev(word + " " + words[i+1]); err == nil {
+					mn.Classifications = appendClassification(mn.Classifications, classes[0])
+					i++
+					continue
+				}
+			}
+		}
+
+		if classes, err := r.ParseClassificationAbbrev(word); err == nil {
+			mn.Classifications = appendClassification(mn.Classifications, classes[0])
+			continue
+		}
+
+		mn.UnknownTokens = append(mn.UnknownTokens, word)
+	}
+
+	if mn.RaceType == 0 {
+		mn.RaceType = RaceType_Flat
+	}
+
+	return mn, nil
+}
+
+// HasUnknownTokens returns true if the market name had words that could not be parsed.
+func (mn MarketName) HasUnknownTokens() bool {
+	return len(mn.UnknownTokens) > 0
+}
+
+// appendClassification appends class to classes, unless it's already there.
+func appendClassification(classes []Classification, class Classification) []Classification {
+	for _, c := range classes {
+		if c == class {
+			return classes
+		}
+	}
+	return append(classes, class)
+}
@@ -0,0 +1,117 @@
+package horserace_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/conversion"
+	"github.com/gustavooferreira/bfutils/horserace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMarketName(t *testing.T) {
+	tests := map[string]struct {
+		marketName   string
+		expectedName horserace.MarketName
+		expectedErr  bool
+	}{
+		"market name: <Empty>":      {marketName: "   ", expectedErr: true},
+		"market name: To Be Placed": {marketName: "To Be Placed", expectedErr: true},
+		"market name: 2m Hrd Chs":   {marketName: "2m Hrd Chs", expectedErr: true},
+		"market name: 2m4f Hcap Chs": {
+			marketName: "2m4f Hcap Chs",
+			expectedName: horserace.MarketName{
+				Distance:        conversion.NewDistance(2, 4, 0),
+				RaceType:        horserace.RaceType_Chase,
+				Classifications: []horserace.Classification{horserace.Classification_Handicap},
+			},
+		},
+		"market name: 7f Mdn Stks": {
+			marketName: "7f  Mdn Stks ",
+			expectedName: horserace.MarketName{
+				Distance:        conversion.NewDistance(0, 7, 0),
+				RaceType:        horserace.RaceType_Flat,
+				Classifications: []horserace.Classification{horserace.Classification_MaidenStakes},
+			},
+		},
+		"market name: 6f Sell Stks": {
+			marketName: "6f Sell Stks",
+			expectedName: horserace.MarketName{
+				Distance:        conversion.NewDistance(0, 6, 0),
+				RaceType:        horserace.RaceType_Flat,
+				Classifications: []horserace.Classification{horserace.Classification_SellingStakes},
+			},
+		},
+		"market name: 1m Claim Stks": {
+			marketName: "1m Claim Stks",
+			expectedName: horserace.MarketName{
+				Distance:        conversion.NewDistance(1, 0, 0),
+				RaceType:        horserace.RaceType_Flat,
+				Classifications: []horserace.Classification{horserace.Classification_ClaimingStakes},
+			},
+		},
+		"market name: 5f Class Stks": {
+			marketName: "5f Class Stks",
+			expectedName: horserace.MarketName{
+				Distance:        conversion.NewDistance(0, 5, 0),
+				RaceType:        horserace.RaceType_Flat,
+				Classifications: []horserace.Classification{horserace.Classification_ClassifiedStakes},
+			},
+		},
+		"market name: 7f Nov Mdn Stks": {
+			marketName: "7f Nov Mdn Stks",
+			expectedName: horserace.MarketName{
+				Distance:        conversion.NewDistance(0, 7, 0),
+				RaceType:        horserace.RaceType_Flat,
+				Classifications: []horserace.Classification{horserace.Classification_Novice, horserace.Classification_MaidenStakes},
+			},
+		},
+		"market name: 2m Hcap Hrd": {
+			marketName: "2m Hcap Hrd",
+			expectedName: horserace.MarketName{
+				Distance:        conversion.NewDistance(2, 0, 0),
+				RaceType:        horserace.RaceType_Hurdle,
+				Classifications: []horserace.Classification{horserace.Classification_Handicap},
+			},
+		},
+		"market name: 1m2f Grp1": {
+			marketName: "1m2f Grp1",
+			expectedName: horserace.MarketName{
+				Distance:        conversion.NewDistance(1, 2, 0),
+				RaceType:        horserace.RaceType_Flat,
+				Classifications: []horserace.Classification{horserace.Classification_Group1},
+			},
+		},
+		"market name: 2m INHF": {
+			marketName:   "2m INHF",
+			expectedName: horserace.MarketName{Distance: conversion.NewDistance(2, 0, 0), RaceType: horserace.RaceType_NHF},
+		},
+		"market name: 3m Nov Hrd Mares": {
+			marketName: "3m Nov Hrd Mares",
+			expectedName: horserace.MarketName{
+				Distance:        conversion.NewDistance(3, 0, 0),
+				RaceType:        horserace.RaceType_Hurdle,
+				Classifications: []horserace.Classification{horserace.Classification_Novice},
+				UnknownTokens:   []string{"Mares"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			mn, err := horserace.ParseMarketName(test.marketName)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.Equal(t, test.expectedName, mn)
+			assert.Equal(t, len(test.expectedName.UnknownTokens) > 0, mn.HasUnknownTokens())
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package horserace
+
+// Racecourse represents the attributes of a racecourse.
+// Attributes that are not known are left as the zero value.
+type Racecourse struct {
+	// Name of the racecourse.
+	Name string
+	// Betfair abbreviation of the racecourse.
+	Abbrev string
+	// Country the racecourse is listed under by betfair.
+	Country Country
+	// Region of the country, e.g. England or Scotland for the UK and provinces for Ireland.
+	Region string
+	// Whether the racecourse holds flat races, jump races or both.
+	Discipline Discipline
+	// Surfaces of the racecourse.
+	Surfaces []Surface
+	// Direction horses run in.
+	Direction Direction
+	// Shape of the racecourse.
+	Shape Shape
+	// Whether the racecourse is level or undulating.
+	Undulation Undulation
+	// Length of the home straight in furlongs.
+	StraightLength float64
+	// Draw bias on the round course.
+	DrawBias DrawBias
+}
+
+// HasSurface returns true if the racecourse has the given surface.
+func (rc Racecourse) HasSurface(surface Surface) bool {
+	for _, s := range rc.Surfaces {
+		if s == surface {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRacecourse returns the racecourse, given the country and the racecourse name.
+func GetRacecourse(country Country, name string) (rc Racecourse, err error) {
+	return defaultRegistry.Racecourse(country, name)
+}
+
+// GetRacecourseFromAbbrev returns the racecourse, given the country and the betfair racecourse abbreviation.
+func GetRacecourseFromAbbrev(country Country, abbrev string) (rc Racecourse, err error) {
+	return defaultRegistry.RacecourseFromAbbrev(country, abbrev)
+}
+
+// GetRacecourses returns all racecourses in the given country.
+func GetRacecourses(country Country) []Racecourse {
+	return defaultRegistry.Racecourses(country)
+}
+
+// RegisterRacecourse adds a racecourse to the default registry, or replaces the attributes of a
+// racecourse with the same name and country.
+// An error is returned if another track in the same country already uses the abbreviation.
+func RegisterRacecourse(rc Racecourse) error {
+	return defaultRegistry.RegisterRacecourse(rc)
+}
+
+// copyRacecourse returns a copy of the racecourse that doesn't share the surfaces with the original.
+func copyRacecourse(rc Racecourse) Racecourse {
+	if rc.Surfaces != nil {
+		rc.Surfaces = append([]Surface{}, rc.Surfaces...)
+	}
+	return rc
+}
+
+// Surface represents the surface of a racecourse.
+type Surface uint
+
+const (
+	// Surface_Turf represents grass.
+	Surface_Turf = iota + 1
+	// Surface_Polytrack represents the Polytrack all-weather surface.
+	Surface_Polytrack
+	// Surface_Tapeta represents the Tapeta all-weather surface.
+	Surface_Tapeta
+	// Surface_Fibresand represents the Fibresand all-weather surface.
+	Surface_Fibresand
+	// Surface_Sand represents a beach.
+	Surface_Sand
+)
+
+// String returns the string representation of Surface.
+func (s Surface) String() string {
+	return [...]string{"", "Turf", "Polytrack", "Tapeta", "Fibresand", "Sand"}[s]
+}
+
+// IsAllWeather returns true if the surface is an all-weather surface.
+func (s Surface) IsAllWeather() bool {
+	return s == Surface_Polytrack || s == Surface_Tapeta || s == Surface_Fibresand
+}
+
+// Direction represents the direction horses run in a racecourse.
+type Direction uint
+
+const (
+	// Direction_LeftHanded represents a left-handed racecourse.
+	Direction_LeftHanded = iota + 1
+	// Direction_RightHanded represents a right-handed racecourse.
+	Direction_RightHanded
+	// Direction_FigureOfEight represents a figure of eight racecourse, with both left and right-hand bends.
+	Direction_FigureOfEight
+	// Direction_Straight represents a racecourse without bends.
+	Direction_Straight
+)
+
+// String returns the string representation of Direction.
+func (d Direction) String() string {
+	return [...]string{"", "Left-handed", "Right-handed", "Figure of eight", "Straight"}[d]
+}
+
+// Shape represents the shape of a racecourse.
+type Shape uint
+
+const (
+	// Shape_Oval represents an oval racecourse.
+	Shape_Oval = iota + 1
+	// Shape_Triangular represents a triangular racecourse.
+	Shape_Triangular
+	// Shape_Pear represents a pear-shaped racecourse.
+	Shape_Pear
+	// Shape_Horseshoe represents a horseshoe racecourse, which is not a complete circuit.
+	Shape_Horseshoe
+	// Shape_Circular represents a tight, circular racecourse.
+	Shape_Circular
+	// Shape_Straight represents a racecourse where most races are run on a straight course.
+	Shape_Straight
+)
+
+// String returns the string representation of Shape.
+func (s Shape) String() string {
+	return [...]string{"", "Oval", "Triangular", "Pear", "Horseshoe", "Circular", "Straight"}[s]
+}
+
+// Undulation represents the terrain of a racecourse.
+type Undulation uint
+
+const (
+	// Undulation_Level represents a mostly level racecourse.
+	Undulation_Level = iota + 1
+	// Undulation_Undulating represents an undulating racecourse.
+	Undulation_Undulating
+)
+
+// String returns the string representation of Undulation.
+func (u Undulation) String() string {
+	return [...]string{"", "Level", "Undulating"}[u]
+}
+
+// DrawBias represents the stalls that are favoured in a racecourse.
+type DrawBias uint
+
+const (
+	// DrawBias_None represents a racecourse without draw bias.
+	DrawBias_None = iota + 1
+	// DrawBias_Low represents a racecourse where low stalls are favoured.
+	DrawBias_Low
+	// DrawBias_High represents a racecourse where high stalls are favoured.
+	DrawBias_High
+)
+
+// String returns the string representation of DrawBias.
+func (db DrawBias) String() string {
+	return [...]string{"", "None", "Low", "High"}[db]
+}
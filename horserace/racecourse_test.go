@@ -0,0 +1,112 @@
+package horserace_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/horserace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRacecourse(t *testing.T) {
+	tests := map[string]struct {
+		country            horserace.Country
+		name               string
+		abbrev             string
+		expectedRacecourse horserace.Racecourse
+		expectedErr        bool
+	}{
+		"racecourse: no country and no name": {expectedErr: true},
+		"racecourse: IRE, Chester":           {country: horserace.Country_IRE, name: "Chester", abbrev: "Chest", expectedErr: true},
+		"racecourse: UK, Chester": {
+			country: horserace.Country_UK,
+			name:    "Chester",
+			abbrev:  "Chest",
+			expectedRacecourse: horserace.Racecourse{Name: "Chester", Abbrev: "Chest", Country: horserace.Country_UK,
+				Region: "England", Discipline: horserace.Discipline_Flat, Surfaces: []horserace.Surface{horserace.Surface_Turf},
+				Direction: horserace.Direction_LeftHanded, Shape: horserace.Shape_Circular, Undulation: horserace.Undulation_Level,
+				StraightLength: 1, DrawBias: horserace.DrawBias_Low},
+		},
+		"racecourse: IRE, Down Royal": {
+			country: horserace.Country_IRE,
+			name:    "Down Royal",
+			abbrev:  "DownR",
+			expectedRacecourse: horserace.Racecourse{Name: "Down Royal", Abbrev: "DownR", Country: horserace.Country_IRE,
+				Region: "Ulster", Discipline: horserace.Discipline_Both, Surfaces: []horserace.Surface{horserace.Surface_Turf},
+				Direction: horserace.Direction_RightHanded, Shape: horserace.Shape_Oval, Undulation: horserace.Undulation_Level},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			rc, err := horserace.GetRacecourse(test.country, test.name)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+			assert.Equal(t, test.expectedRacecourse, rc)
+
+			rc, err = horserace.GetRacecourseFromAbbrev(test.country, test.abbrev)
+			require.Equal(t, test.expectedErr, err != nil, "error field")
+			assert.Equal(t, test.expectedRacecourse, rc)
+		})
+	}
+}
+
+func TestRacecoursesMatchAbbreviations(t *testing.T) {
+	for _, country := range []horserace.Country{horserace.Country_UK, horserace.Country_IRE} {
+		for _, rc := range horserace.GetRacecourses(country) {
+			track, err := horserace.GetTrackNameFromAbbrev(country, rc.Abbrev)
+			require.NoError(t, err, rc.Name)
+			assert.Equal(t, rc.Name, track)
+		}
+	}
+}
+
+func TestRegisterRacecourse(t *testing.T) {
+	tests := map[string]struct {
+		racecourse  horserace.Racecourse
+		expectedErr bool
+	}{
+		"register: no name":      {racecourse: horserace.Racecourse{Abbrev: "Test", Country: horserace.Country_UK}, expectedErr: true},
+		"register: no abbrev":    {racecourse: horserace.Racecourse{Name: "Test Park", Country: horserace.Country_UK}, expectedErr: true},
+		"register: no country":   {racecourse: horserace.Racecourse{Name: "Test Park", Abbrev: "TestP"}, expectedErr: true},
+		"register: abbrev taken": {racecourse: horserace.Racecourse{Name: "Test Park", Abbrev: "York", Country: horserace.Country_UK}, expectedErr: true},
+		"register: new racecourse": {racecourse: horserace.Racecourse{Name: "Test Park", Abbrev: "TestP", Country: horserace.Country_UK,
+			Surfaces: []horserace.Surface{horserace.Surface_Tapeta}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := horserace.NewRegistry()
+
+			var errBool bool
+			var errMsg string
+			err := r.RegisterRacecourse(test.racecourse)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			rc, err := r.RacecourseFromAbbrev(test.racecourse.Country, test.racecourse.Abbrev)
+			require.NoError(t, err)
+			assert.Equal(t, test.racecourse, rc)
+
+			_, err = horserace.GetRacecourseFromAbbrev(test.racecourse.Country, test.racecourse.Abbrev)
+			assert.Error(t, err, "default registry left untouched")
+			assert.True(t, rc.HasSurface(horserace.Surface_Tapeta))
+			assert.True(t, rc.Surfaces[0].IsAllWeather())
+		})
+	}
+}
@@ -65,78 +65,13 @@ var ukTrackToAbbrev = map[string]string{
 	"York":            "York",
 }
 
-// ukAbbrevToTrack is a map from Abbreviation to Track
-var ukAbbrevToTrack = map[string]string{
-	"Aint":   "Aintree",
-	"Ascot":  "Ascot",
-	"Ayr":    "Ayr",
-	"Bang":   "Bangor",
-	"Bath":   "Bath",
-	"Bev":    "Beverley",
-	"Brig":   "Brighton",
-	"Carl":   "Carlisle",
-	"Cart":   "Cartmel",
-	"Catt":   "Catterick",
-	"Chelt":  "Cheltenham",
-	"ChelmC": "Chelmsford City",
-	"Chep":   "Chepstow",
-	"Chest":  "Chester",
-	"Donc":   "Doncaster",
-	"Epsm":   "Epsom",
-	"Extr":   "Exeter",
-	"Fake":   "Fakenham",
-	"FfosL":  "Ffos Las",
-	"Folk":   "Folkstone",
-	"Font":   "Fontwell",
-	"Good":   "Goodwood",
-	"Ham":    "Hamilton",
-	"Hayd":   "Haydock",
-	"Here":   "Hereford",
-	"Hex":    "Hexham",
-	"Hunt":   "Huntingdon",
-	"Kelso":  "Kelso",
-	"Kemp":   "Kempton",
-	"Leic":   "Leicester",
-	"Ling":   "Lingfield",
-	"Ludl":   "Ludlow",
-	"MrktR":  "Market Rasen",
-	"Muss":   "Musselburgh",
-	"Newb":   "Newbury",
-	"Newc":   "Newcastle",
-	"Newm":   "Newmarket",
-	"Newt":   "Newton Abbot",
-	"Nott":   "Nottingham",
-	"Perth":  "Perth",
-	"Plump":  "Plumpton",
-	"Ponte":  "Pontefract",
-	"Redc":   "Redcar",
-	"Ripon":  "Ripon",
-	"Salis":  "Salisbury",
-	"Sand":   "Sandown",
-	"Sedge":  "Sedgefield",
-	"Sthl":   "Southwell",
-	"Strat":  "Stratford",
-	"Taun":   "Taunton",
-	"Thirsk": "Thirsk",
-	"Towc":   "Towcester",
-	"Uttox":  "Uttoxeter",
-	"Warw":   "Warwick",
-	"Weth":   "Wetherby",
-	"Winc":   "Wincanton",
-	"Wind":   "Windsor",
-	"Wolv":   "Wolverhampton",
-	"Worc":   "Worcester",
-	"Yarm":   "Yarmouth",
-	"York":   "York",
-}
-
 // ireTrackToAbbrev is a map from Track to Abbreviation
 var ireTrackToAbbrev = map[string]string{
 	"Ballinrobe":   "Ballin",
 	"Bellewstown":  "Belle",
 	"Clonmel":      "Clon",
 	"Cork":         "Cork",
-	"Down Royal ":  "DownR",
+	"Down Royal":   "DownR",
 	"Downpatrick":  "DownP",
 	"Dundalk":      "Dund",
 	"Fairyhouse":   "Fairy",
@@ -162,34 +97,296 @@ var ireTrackToAbbrev = map[string]string{
 	"Wexford":      "Wex",
 }
 
-// ireAbbrevToTrack is a map from Abbreviation to Track
-var ireAbbrevToTrack = map[string]string{
-	"Ballin": "Ballinrobe",
-	"Belle":  "Bellewstown",
-	"Clon":   "Clonmel",
-	"Cork":   "Cork",
-	"DownR":  "Down Royal ",
-	"DownP":  "Downpatrick",
-	"Dund":   "Dundalk",
-	"Fairy":  "Fairyhouse",
-	"Gal":    "Galway",
-	"GowP":   "Gowran Park",
-	"Killar": "Killarney",
-	"Kilb":   "Kilbeggan",
-	"Layt":   "Laytown",
-	"Leop":   "Leopardstown",
-	"Lim":    "Limerick",
-	"List":   "Listowel",
-	"Naas":   "Naas",
-	"Navan":  "Navan",
-	"Punch":  "Punchestown",
-	"Rosc":   "Roscommon",
-	"Shelb":  "Shelbourne",
-	"Sligo":  "Sligo",
-	"Curr":   "The Curragh",
-	"Thurl":  "Thurles",
-	"Tipp":   "Tipperary",
-	"Tral":   "Tralee",
-	"Tram":   "Tramore",
-	"Wex":    "Wexford",
+// frTrackToAbbrev is a map from Track to Abbreviation
+var frTrackToAbbrev = map[string]string{
+	"Auteuil":          "Aut",
+	"Cagnes-sur-Mer":   "Cagn",
+	"Chantilly":        "Chant",
+	"Clairefontaine":   "Clair",
+	"Compiegne":        "Comp",
+	"Deauville":        "Deau",
+	"Longchamp":        "Longc",
+	"Lyon Parilly":     "LyonP",
+	"Marseille Borely": "MarsB",
+	"Pau":              "Pau",
+	"Saint-Cloud":      "StCl",
+	"Toulouse":         "Toul",
+	"Vichy":            "Vichy",
+}
+
+// usTrackToAbbrev is a map from Track to Abbreviation
+var usTrackToAbbrev = map[string]string{
+	"Aqueduct":           "Aqu",
+	"Belmont Park":       "Belm",
+	"Charles Town":       "CharT",
+	"Churchill Downs":    "ChurD",
+	"Del Mar":            "DelM",
+	"Fair Grounds":       "FairG",
+	"Golden Gate Fields": "GoldG",
+	"Gulfstream Park":    "GulfP",
+	"Keeneland":          "Keen",
+	"Laurel Park":        "LaurP",
+	"Oaklawn Park":       "OakP",
+	"Parx Racing":        "Parx",
+	"Penn National":      "PennN",
+	"Santa Anita":        "SAnita",
+	"Saratoga":           "Sara",
+	"Tampa Bay Downs":    "TampB",
+	"Turfway Park":       "TurfP",
+}
+
+// ausTrackToAbbrev is a map from Track to Abbreviation
+var ausTrackToAbbrev = map[string]string{
+	"Ascot":         "Ascot",
+	"Canterbury":    "Cant",
+	"Caulfield":     "Caul",
+	"Doomben":       "Doom",
+	"Eagle Farm":    "EagF",
+	"Flemington":    "Flem",
+	"Gold Coast":    "GoldC",
+	"Moonee Valley": "MooV",
+	"Morphettville": "Morph",
+	"Pakenham":      "Pak",
+	"Randwick":      "Rand",
+	"Rosehill":      "Rose",
+	"Sandown":       "Sand",
+	"Warwick Farm":  "WarF",
+}
+
+// rsaTrackToAbbrev is a map from Track to Abbreviation
+var rsaTrackToAbbrev = map[string]string{
+	"Durbanville":   "Durb",
+	"Fairview":      "Fairv",
+	"Flamingo Park": "FlamP",
+	"Greyville":     "Greyv",
+	"Kenilworth":    "Kenil",
+	"Scottsville":   "Scotv",
+	"Turffontein":   "Turff",
+	"Vaal":          "Vaal",
+}
+
+// uaeTrackToAbbrev is a map from Track to Abbreviation
+var uaeTrackToAbbrev = map[string]string{
+	"Abu Dhabi": "AbuD",
+	"Al Ain":    "AlAin",
+	"Jebel Ali": "JebA",
+	"Meydan":    "Meyd",
+	"Sharjah":   "Sharj",
+}
+
+// embeddedTrackToAbbrev is a map from Country to the map from Track to Abbreviation
+var embeddedTrackToAbbrev = map[Country]map[string]string{
+	Country_UK:  ukTrackToAbbrev,
+	Country_IRE: ireTrackToAbbrev,
+	Country_FR:  frTrackToAbbrev,
+	Country_US:  usTrackToAbbrev,
+	Country_AUS: ausTrackToAbbrev,
+	Country_RSA: rsaTrackToAbbrev,
+	Country_UAE: uaeTrackToAbbrev,
+}
+
+// embeddedAliases is a map from Country to the map from official or common alternative track names to Track.
+// All-weather suffixes such as "(AW)" are ignored when resolving track names, so they don't need an alias.
+var embeddedAliases = map[Country]map[string]string{
+	Country_UK: {
+		"Bangor-on-Dee":       "Bangor",
+		"Catterick Bridge":    "Catterick",
+		"Chelmsford":          "Chelmsford City",
+		"Epsom Downs":         "Epsom",
+		"Folkestone":          "Folkstone",
+		"Fontwell Park":       "Fontwell",
+		"Haydock Park":        "Haydock",
+		"Kempton Park":        "Kempton",
+		"Lingfield Park":      "Lingfield",
+		"Newmarket July":      "Newmarket",
+		"Newmarket Rowley":    "Newmarket",
+		"Sandown Park":        "Sandown",
+		"Stratford-on-Avon":   "Stratford",
+		"Stratford-upon-Avon": "Stratford",
+	},
+	Country_IRE: {
+		"Curragh": "The Curragh",
+		"Gowran":  "Gowran Park",
+	},
+}
+
+// embeddedRacecourses holds the attributes of the racecourses embedded in the package.
+var embeddedRacecourses = []Racecourse{
+	{Name: "Aintree", Abbrev: "Aint", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Triangular, Undulation: Undulation_Level},
+	{Name: "Ascot", Abbrev: "Ascot", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Triangular, Undulation: Undulation_Undulating, StraightLength: 2.5},
+	{Name: "Ayr", Abbrev: "Ayr", Country: Country_UK, Region: "Scotland",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Bangor", Abbrev: "Bang", Country: Country_UK, Region: "Wales",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Undulation: Undulation_Level},
+	{Name: "Bath", Abbrev: "Bath", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Beverley", Abbrev: "Bev", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating, DrawBias: DrawBias_High},
+	{Name: "Brighton", Abbrev: "Brig", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Horseshoe, Undulation: Undulation_Undulating},
+	{Name: "Carlisle", Abbrev: "Carl", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Pear, Undulation: Undulation_Undulating},
+	{Name: "Cartmel", Abbrev: "Cart", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Catterick", Abbrev: "Catt", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Cheltenham", Abbrev: "Chelt", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Chelmsford City", Abbrev: "ChelmC", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Polytrack}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Chepstow", Abbrev: "Chep", Country: Country_UK, Region: "Wales",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Chester", Abbrev: "Chest", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Circular, Undulation: Undulation_Level, StraightLength: 1, DrawBias: DrawBias_Low},
+	{Name: "Doncaster", Abbrev: "Donc", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Pear, Undulation: Undulation_Level, StraightLength: 4.5},
+	{Name: "Epsom", Abbrev: "Epsm", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Horseshoe, Undulation: Undulation_Undulating, StraightLength: 3.5},
+	{Name: "Exeter", Abbrev: "Extr", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Fakenham", Abbrev: "Fake", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Ffos Las", Abbrev: "FfosL", Country: Country_UK, Region: "Wales",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Folkstone", Abbrev: "Folk", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Fontwell", Abbrev: "Font", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_FigureOfEight, Undulation: Undulation_Undulating},
+	{Name: "Goodwood", Abbrev: "Good", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Undulation: Undulation_Undulating},
+	{Name: "Hamilton", Abbrev: "Ham", Country: Country_UK, Region: "Scotland",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Pear, Undulation: Undulation_Undulating},
+	{Name: "Haydock", Abbrev: "Hayd", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Hereford", Abbrev: "Here", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Undulation: Undulation_Level},
+	{Name: "Hexham", Abbrev: "Hex", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Huntingdon", Abbrev: "Hunt", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Kelso", Abbrev: "Kelso", Country: Country_UK, Region: "Scotland",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval},
+	{Name: "Kempton", Abbrev: "Kemp", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf, Surface_Polytrack}, Direction: Direction_RightHanded, Shape: Shape_Triangular, Undulation: Undulation_Level},
+	{Name: "Leicester", Abbrev: "Leic", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Undulation: Undulation_Undulating},
+	{Name: "Lingfield", Abbrev: "Ling", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf, Surface_Polytrack}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Ludlow", Abbrev: "Ludl", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Market Rasen", Abbrev: "MrktR", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval},
+	{Name: "Musselburgh", Abbrev: "Muss", Country: Country_UK, Region: "Scotland",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Newbury", Abbrev: "Newb", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Newcastle", Abbrev: "Newc", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf, Surface_Tapeta}, Direction: Direction_LeftHanded},
+	{Name: "Newmarket", Abbrev: "Newm", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Straight, Undulation: Undulation_Undulating},
+	{Name: "Newton Abbot", Abbrev: "Newt", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Nottingham", Abbrev: "Nott", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Perth", Abbrev: "Perth", Country: Country_UK, Region: "Scotland",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Plumpton", Abbrev: "Plump", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Pontefract", Abbrev: "Ponte", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Redcar", Abbrev: "Redc", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Ripon", Abbrev: "Ripon", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval},
+	{Name: "Salisbury", Abbrev: "Salis", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Undulation: Undulation_Undulating},
+	{Name: "Sandown", Abbrev: "Sand", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Sedgefield", Abbrev: "Sedge", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Southwell", Abbrev: "Sthl", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf, Surface_Fibresand}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Stratford", Abbrev: "Strat", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Triangular, Undulation: Undulation_Level},
+	{Name: "Taunton", Abbrev: "Taun", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Thirsk", Abbrev: "Thirsk", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Towcester", Abbrev: "Towc", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Undulation: Undulation_Undulating},
+	{Name: "Uttoxeter", Abbrev: "Uttox", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Warwick", Abbrev: "Warw", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded},
+	{Name: "Wetherby", Abbrev: "Weth", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Wincanton", Abbrev: "Winc", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Windsor", Abbrev: "Wind", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_FigureOfEight, Undulation: Undulation_Level},
+	{Name: "Wolverhampton", Abbrev: "Wolv", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Tapeta}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Worcester", Abbrev: "Worc", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Yarmouth", Abbrev: "Yarm", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "York", Abbrev: "York", Country: Country_UK, Region: "England",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Horseshoe, Undulation: Undulation_Level, StraightLength: 5},
+	{Name: "Ballinrobe", Abbrev: "Ballin", Country: Country_IRE, Region: "Connacht",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval},
+	{Name: "Bellewstown", Abbrev: "Belle", Country: Country_IRE, Region: "Leinster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Clonmel", Abbrev: "Clon", Country: Country_IRE, Region: "Munster",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Cork", Abbrev: "Cork", Country: Country_IRE, Region: "Munster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Down Royal", Abbrev: "DownR", Country: Country_IRE, Region: "Ulster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Downpatrick", Abbrev: "DownP", Country: Country_IRE, Region: "Ulster",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Dundalk", Abbrev: "Dund", Country: Country_IRE, Region: "Leinster",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Polytrack}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Fairyhouse", Abbrev: "Fairy", Country: Country_IRE, Region: "Leinster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval},
+	{Name: "Galway", Abbrev: "Gal", Country: Country_IRE, Region: "Connacht",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Gowran Park", Abbrev: "GowP", Country: Country_IRE, Region: "Leinster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Killarney", Abbrev: "Killar", Country: Country_IRE, Region: "Munster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Kilbeggan", Abbrev: "Kilb", Country: Country_IRE, Region: "Leinster",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Laytown", Abbrev: "Layt", Country: Country_IRE, Region: "Leinster",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Sand}, Direction: Direction_Straight, Shape: Shape_Straight, Undulation: Undulation_Level},
+	{Name: "Leopardstown", Abbrev: "Leop", Country: Country_IRE, Region: "Leinster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Limerick", Abbrev: "Lim", Country: Country_IRE, Region: "Munster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval},
+	{Name: "Listowel", Abbrev: "List", Country: Country_IRE, Region: "Munster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Naas", Abbrev: "Naas", Country: Country_IRE, Region: "Leinster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Navan", Abbrev: "Navan", Country: Country_IRE, Region: "Leinster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Punchestown", Abbrev: "Punch", Country: Country_IRE, Region: "Leinster",
+		Discipline: Discipline_Jumps, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Roscommon", Abbrev: "Rosc", Country: Country_IRE, Region: "Connacht",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Shelbourne", Abbrev: "Shelb", Country: Country_IRE, Region: "Leinster"},
+	{Name: "Sligo", Abbrev: "Sligo", Country: Country_IRE, Region: "Connacht",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "The Curragh", Abbrev: "Curr", Country: Country_IRE, Region: "Leinster",
+		Discipline: Discipline_Flat, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Horseshoe, Undulation: Undulation_Undulating},
+	{Name: "Thurles", Abbrev: "Thurl", Country: Country_IRE, Region: "Munster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Tipperary", Abbrev: "Tipp", Country: Country_IRE, Region: "Munster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_LeftHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
+	{Name: "Tralee", Abbrev: "Tral", Country: Country_IRE, Region: "Munster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval},
+	{Name: "Tramore", Abbrev: "Tram", Country: Country_IRE, Region: "Munster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Undulating},
+	{Name: "Wexford", Abbrev: "Wex", Country: Country_IRE, Region: "Leinster",
+		Discipline: Discipline_Both, Surfaces: []Surface{Surface_Turf}, Direction: Direction_RightHanded, Shape: Shape_Oval, Undulation: Undulation_Level},
 }
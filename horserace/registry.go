@@ -0,0 +1,580 @@
+package horserace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Registry holds the racecourses, track abbreviations and classification abbreviations used by the package.
+// A new registry starts with the embedded defaults, which can then be overridden by loading files.
+// A registry is safe for concurrent use.
+type Registry struct {
+	mu            sync.RWMutex
+	trackToAbbrev map[Country]map[string]string
+	abbrevToTrack map[Country]map[string]string
+	aliases       map[Country]map[string]string
+	classes       []classEntry
+	racecourses   []Racecourse
+}
+
+// classEntry represents a classification and its betfair abbreviation.
+type classEntry struct {
+	name   string
+	abbrev string
+}
+
+// defaultRegistry is the registry used by the package level functions.
+var defaultRegistry = NewRegistry()
+
+// DefaultRegistry returns the registry used by the package level functions, e.g. GetTrackNameFromAbbrev.
+// Loading files into it changes the results of those functions.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// NewRegistry returns a new registry with the embedded defaults.
+func NewRegistry() *Registry {
+	r := &Registry{
+		trackToAbbrev: map[Country]map[string]string{},
+		abbrevToTrack: map[Country]map[string]string{},
+		aliases:       map[Country]map[string]string{},
+	}
+
+	for country, tracks := range embeddedTrackToAbbrev {
+		r.trackToAbbrev[country] = copyMap(tracks)
+		r.abbrevToTrack[country] = reverseMap(tracks)
+	}
+
+	for country, aliases := range embeddedAliases {
+		for alias, track := range aliases {
+			r.setAlias(country, alias, track)
+		}
+	}
+
+	for _, info := range classifications[1:] {
+		r.classes = append(r.classes, classEntry{name: info.name, abbrev: info.abbrev})
+	}
+
+	for _, rc := range embeddedRacecourses {
+		r.racecourses = append(r.racecourses, copyRacecourse(rc))
+	}
+
+	return r
+}
+
+// TrackName returns the track name, given the country and the betfair track abbreviation.
+func (r *Registry) TrackName(country Country, abbrev string) (track string, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tracks, ok := r.abbrevToTrack[country]
+	if !ok {
+		return "", fmt.Errorf("country [%s] not supported", country)
+	}
+
+	if track, ok = tracks[abbrev]; ok {
+		return track, nil
+	}
+	return "", fmt.Errorf("couldn't find track with abbreviation [%s] in country [%s]", abbrev, country)
+}
+
+// TrackAbbrev returns the betfair track abbreviation, given the country and the track name.
+func (r *Registry) TrackAbbrev(country Country, track string) (abbrev string, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	abbrevs, ok := r.trackToAbbrev[country]
+	if !ok {
+		return "", fmt.Errorf("country [%s] not supported", country)
+	}
+
+	if abbrev, ok = abbrevs[track]; ok {
+		return abbrev, nil
+	}
+	return "", fmt.Errorf("couldn't find track [%s] in country [%s]", track, country)
+}
+
+// Tracks returns a map from track name to betfair abbreviation of all tracks in the given country.
+func (r *Registry) Tracks(country Country) map[string]string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return copyMap(r.trackToAbbrev[country])
+}
+
+// Classifications returns all race classifications that share the given betfair abbreviation,
+// starting with the most common one.
+func (r *Registry) Classifications(abbrev string) (classes []string, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, entry := range r.classes {
+		if entry.abbrev == abbrev {
+			classes = append(classes, entry.name)
+		}
+	}
+
+	if len(classes) == 0 {
+		return nil, fmt.Errorf("couldn't find match")
+	}
+	return classes, nil
+}
+
+// ClassificationAbbrev returns the betfair classification abbreviation, given the race classification.
+func (r *Registry) ClassificationAbbrev(class string) (abbrev string, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, entry := range r.classes {
+		if entry.name == class {
+			return entry.abbrev, nil
+		}
+	}
+	return "", fmt.Errorf("couldn't find match")
+}
+
+// ParseClassificationAbbrev returns all classifications that share the given betfair abbreviation,
+// starting with the most common one.
+// Classifications added to the registry that are not a Classification are left out.
+func (r *Registry) ParseClassificationAbbrev(abbrev string) ([]Classification, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	classes := []Classification{}
+	for _, entry := range r.classes {
+		if entry.abbrev != abbrev {
+			continue
+		}
+
+		if class, err := ParseClassification(entry.name); err == nil {
+			classes = append(classes, class)
+		}
+	}
+
+	if len(classes) == 0 {
+		return nil, fmt.Errorf("unknown classification abbreviation [%s]", abbrev)
+	}
+	return classes, nil
+}
+
+// Racecourse returns the racecourse, given the country and the racecourse name.
+func (r *Registry) Racecourse(country Country, name string) (rc Racecourse, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, rc := range r.racecourses {
+		if rc.Country == country && rc.Name == name {
+			return copyRacecourse(rc), nil
+		}
+	}
+	return rc, fmt.Errorf("couldn't find racecourse [%s] in country [%s]", name, country)
+}
+
+// RacecourseFromAbbrev returns the racecourse, given the country and the betfair racecourse abbreviation.
+func (r *Registry) RacecourseFromAbbrev(country Country, abbrev string) (rc Racecourse, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, rc := range r.racecourses {
+		if rc.Country == country && rc.Abbrev == abbrev {
+			return copyRacecourse(rc), nil
+		}
+	}
+	return rc, fmt.Errorf("couldn't find racecourse with abbreviation [%s] in country [%s]", abbrev, country)
+}
+
+// Racecourses returns all racecourses in the given country.
+func (r *Registry) Racecourses(country Country) []Racecourse {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := []Racecourse{}
+	for _, rc := range r.racecourses {
+		if rc.Country == country {
+			result = append(result, copyRacecourse(rc))
+		}
+	}
+	return result
+}
+
+// RegisterRacecourse adds a racecourse to the registry, or replaces the attributes of a racecourse
+// with the same name and country. The track abbreviation is registered as well.
+// An error is returned if another track in the same country already uses the abbreviation.
+func (r *Registry) RegisterRacecourse(rc Racecourse) error {
+	if rc.Name == "" {
+		return fmt.Errorf("racecourse name cannot be empty")
+	}
+
+	if rc.Country == 0 {
+		return fmt.Errorf("racecourse [%s] country cannot be empty", rc.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.setTrack(rc.Country, rc.Name, rc.Abbrev); err != nil {
+		return err
+	}
+
+	rc = copyRacecourse(rc)
+	for i := range r.racecourses {
+		if r.racecourses[i].Country == rc.Country && r.racecourses[i].Name == rc.Name {
+			r.racecourses[i] = rc
+			return nil
+		}
+	}
+
+	r.racecourses = append(r.racecourses, rc)
+	return nil
+}
+
+// FileFormat represents the format of a file loaded into a registry.
+type FileFormat uint
+
+const (
+	// FileFormat_JSON represents the JSON format.
+	FileFormat_JSON = iota + 1
+	// FileFormat_YAML represents the YAML format.
+	FileFormat_YAML
+)
+
+// String returns the string representation of FileFormat.
+func (ff FileFormat) String() string {
+	return [...]string{"", "JSON", "YAML"}[ff]
+}
+
+// registryFile represents the contents of a file loaded into a registry.
+// Tracks are keyed by country, either as returned by Country.String or as an ISO country code.
+type registryFile struct {
+	Tracks          map[string][]registryFileEntry `json:"tracks" yaml:"tracks"`
+	Classifications []registryFileEntry            `json:"classifications" yaml:"classifications"`
+}
+
+// registryFileEntry represents a track or a classification in a file loaded into a registry.
+// Entries with Remove set to true remove the track or classification with the same name.
+// Aliases are only used by tracks.
+type registryFileEntry struct {
+	Name    string   `json:"name" yaml:"name"`
+	Abbrev  string   `json:"abbrev" yaml:"abbrev"`
+	Aliases []string `json:"aliases" yaml:"aliases"`
+	Remove  bool     `json:"remove" yaml:"remove"`
+}
+
+// Load reads tracks and classifications from r and merges them into the registry.
+// Tracks and classifications with the same name as existing ones replace them, and all others
+// are added. If the file is not valid or the result is not consistent, the registry is left unchanged.
+// An empty file, in either format, leaves the registry unchanged as well.
+// Classifications are matched by the name returned by Classification.String, and the abbreviations
+// loaded are the ones used by the registry's ParseClassificationAbbrev and ParseMarketName, and by
+// Classification.Abbrev for the default registry.
+//
+// Example of a file in YAML:
+//
+//	tracks:
+//	  UK:
+//	    - name: Folkstone
+//	      remove: true
+//	    - name: Newcastle
+//	      abbrev: Newc
+//	      aliases: [Newcastle (AW)]
+//	classifications:
+//	  - name: Handicap
+//	    abbrev: Hcap
+func (r *Registry) Load(reader io.Reader, format FileFormat) error {
+	file := registryFile{}
+
+	switch format {
+	case FileFormat_JSON:
+		if err := json.NewDecoder(reader).Decode(&file); err != nil && err != io.EOF {
+			return fmt.Errorf("couldn't decode JSON: %w", err)
+		}
+	case FileFormat_YAML:
+		if err := yaml.NewDecoder(reader).Decode(&file); err != nil && err != io.EOF {
+			return fmt.Errorf("couldn't decode YAML: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown file format")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	merged := r.clone()
+
+	for key, entries := range file.Tracks {
+		country, err := parseCountry(key)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if err := merged.mergeTrack(country, entry); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, entry := range file.Classifications {
+		if err := merged.mergeClass(entry); err != nil {
+			return err
+		}
+	}
+
+	if err := merged.validate(); err != nil {
+		return err
+	}
+
+	r.trackToAbbrev = merged.trackToAbbrev
+	r.abbrevToTrack = merged.abbrevToTrack
+	r.aliases = merged.aliases
+	r.classes = merged.classes
+	r.racecourses = merged.racecourses
+
+	return nil
+}
+
+// LoadFile reads tracks and classifications from a JSON or YAML file and merges them into the registry.
+// The format is chosen from the file extension: .json, .yaml or .yml.
+func (r *Registry) LoadFile(path string) error {
+	var format FileFormat
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		format = FileFormat_JSON
+	case ".yaml", ".yml":
+		format = FileFormat_YAML
+	default:
+		return fmt.Errorf("unknown file format for file [%s]", path)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return r.Load(file, format)
+}
+
+// Validate returns an error if the registry is not consistent, e.g. if a track abbreviation
+// doesn't map back to the same track.
+func (r *Registry) Validate() error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.validate()
+}
+
+func (r *Registry) validate() error {
+	for country, tracks := range r.trackToAbbrev {
+		abbrevs := r.abbrevToTrack[country]
+		if len(tracks) != len(abbrevs) {
+			return fmt.Errorf("country [%s] has %d tracks but %d abbreviations", country, len(tracks), len(abbrevs))
+		}
+
+		for track, abbrev := range tracks {
+			if track == "" || abbrev == "" {
+				return fmt.Errorf("country [%s] has a track with an empty name or abbreviation", country)
+			}
+
+			if abbrevs[abbrev] != track {
+				return fmt.Errorf("abbreviation [%s] of track [%s] in country [%s] maps to track [%s]",
+					abbrev, track, country, abbrevs[abbrev])
+			}
+		}
+	}
+
+	for country, aliases := range r.aliases {
+		for alias, track := range aliases {
+			if _, ok := r.trackToAbbrev[country][track]; !ok {
+				return fmt.Errorf("alias [%s] in country [%s] refers to unknown track [%s]", alias, country, track)
+			}
+		}
+	}
+
+	names := map[string]bool{}
+	for _, entry := range r.classes {
+		if entry.name == "" || entry.abbrev == "" {
+			return fmt.Errorf("classification with an empty name or abbreviation")
+		}
+
+		if names[entry.name] {
+			return fmt.Errorf("classification [%s] is duplicated", entry.name)
+		}
+		names[entry.name] = true
+	}
+
+	for _, rc := range r.racecourses {
+		if abbrev := r.trackToAbbrev[rc.Country][rc.Name]; abbrev != rc.Abbrev {
+			return fmt.Errorf("racecourse [%s] in country [%s] has abbreviation [%s] but track has [%s]",
+				rc.Name, rc.Country, rc.Abbrev, abbrev)
+		}
+	}
+
+	return nil
+}
+
+// clone returns a copy of the registry that doesn't share any data with the original.
+func (r *Registry) clone() *Registry {
+	c := &Registry{
+		trackToAbbrev: map[Country]map[string]string{},
+		abbrevToTrack: map[Country]map[string]string{},
+		aliases:       map[Country]map[string]string{},
+		classes:       append([]classEntry{}, r.classes...),
+	}
+
+	for country, aliases := range r.aliases {
+		c.aliases[country] = copyMap(aliases)
+	}
+
+	for country, tracks := range r.trackToAbbrev {
+		c.trackToAbbrev[country] = copyMap(tracks)
+	}
+
+	for country, abbrevs := range r.abbrevToTrack {
+		c.abbrevToTrack[country] = copyMap(abbrevs)
+	}
+
+	for _, rc := range r.racecourses {
+		c.racecourses = append(c.racecourses, copyRacecourse(rc))
+	}
+
+	return c
+}
+
+// mergeTrack adds, replaces or removes a track.
+func (r *Registry) mergeTrack(country Country, entry registryFileEntry) error {
+	if entry.Name == "" {
+		return fmt.Errorf("track name cannot be empty")
+	}
+
+	if !entry.Remove {
+		if err := r.setTrack(country, entry.Name, entry.Abbrev); err != nil {
+			return err
+		}
+
+		for _, alias := range entry.Aliases {
+			r.setAlias(country, alias, entry.Name)
+		}
+
+		// Keep the racecourse attributes in line with the new abbreviation
+		for i := range r.racecourses {
+			if r.racecourses[i].Country == country && r.racecourses[i].Name == entry.Name {
+				r.racecourses[i].Abbrev = entry.Abbrev
+			}
+		}
+		return nil
+	}
+
+	if abbrev, ok := r.trackToAbbrev[country][entry.Name]; ok {
+		delete(r.trackToAbbrev[country], entry.Name)
+		delete(r.abbrevToTrack[country], abbrev)
+	}
+
+	for alias, track := range r.aliases[country] {
+		if track == entry.Name {
+			delete(r.aliases[country], alias)
+		}
+	}
+
+	racecourses := r.racecourses[:0]
+	for _, rc := range r.racecourses {
+		if rc.Country != country || rc.Name != entry.Name {
+			racecourses = append(racecourses, rc)
+		}
+	}
+	r.racecourses = racecourses
+
+	return nil
+}
+
+// setTrack adds or replaces the abbreviation of a track.
+func (r *Registry) setTrack(country Country, track string, abbrev string) error {
+	if abbrev == "" {
+		return fmt.Errorf("track [%s] abbreviation cannot be empty", track)
+	}
+
+	if other, ok := r.abbrevToTrack[country][abbrev]; ok && other != track {
+		return fmt.Errorf("abbreviation [%s] already used by track [%s] in country [%s]", abbrev, other, country)
+	}
+
+	if r.trackToAbbrev[country] == nil {
+		r.trackToAbbrev[country] = map[string]string{}
+		r.abbrevToTrack[country] = map[string]string{}
+	}
+
+	if old, ok := r.trackToAbbrev[country][track]; ok {
+		delete(r.abbrevToTrack[country], old)
+	}
+
+	r.trackToAbbrev[country][track] = abbrev
+	r.abbrevToTrack[country][abbrev] = track
+
+	return nil
+}
+
+// setAlias adds or replaces an alternative name of a track.
+func (r *Registry) setAlias(country Country, alias string, track string) {
+	if r.aliases[country] == nil {
+		r.aliases[country] = map[string]string{}
+	}
+	r.aliases[country][normaliseTrackName(alias)] = track
+}
+
+// mergeClass adds, replaces or removes a classification.
+func (r *Registry) mergeClass(entry registryFileEntry) error {
+	if entry.Name == "" {
+		return fmt.Errorf("classification name cannot be empty")
+	}
+
+	for i := range r.classes {
+		if r.classes[i].name != entry.Name {
+			continue
+		}
+
+		if entry.Remove {
+			r.classes = append(r.classes[:i], r.classes[i+1:]...)
+		} else {
+			r.classes[i].abbrev = entry.Abbrev
+		}
+		return nil
+	}
+
+	if !entry.Remove {
+		r.classes = append(r.classes, classEntry{name: entry.Name, abbrev: entry.Abbrev})
+	}
+	return nil
+}
+
+// parseCountry returns the Country, given its string representation or its ISO country code.
+func parseCountry(s string) (Country, error) {
+	for c := Country(Country_UK); c <= Country_UAE; c++ {
+		if c.String() == s {
+			return c, nil
+		}
+	}
+	return ParseCountryCode(s)
+}
+
+func copyMap(m map[string]string) map[string]string {
+	c := make(map[string]string, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+func reverseMap(m map[string]string) map[string]string {
+	r := make(map[string]string, len(m))
+	for k, v := range m {
+		r[v] = k
+	}
+	return r
+}
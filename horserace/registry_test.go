@@ -0,0 +1,180 @@
+package horserace_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/horserace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRegistry(t *testing.T) {
+	r := horserace.NewRegistry()
+	require.NoError(t, r.Validate())
+
+	track, err := r.TrackName(horserace.Country_IRE, "DownR")
+	require.NoError(t, err)
+	assert.Equal(t, "Down Royal", track)
+
+	classes, err := r.Classifications("Hcap")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Handicap", "Showcase Handicap", "Rated Stakes"}, classes)
+}
+
+func TestRegistryLoad(t *testing.T) {
+	jsonFile := `{
+		"tracks": {
+			"UK": [{"name": "Folkstone", "remove": true}, {"name": "Test Downs", "abbrev": "TestD"}],
+			"IE": [{"name": "Naas", "abbrev": "Naas2"}]
+		},
+		"classifications": [{"name": "Rated Stakes", "abbrev": "Rated"}, {"name": "Chase", "remove": true}]
+	}`
+
+	yamlFile := `
+tracks:
+  UK:
+    - name: Folkstone
+      remove: true
+    - name: Test Downs
+      abbrev: TestD
+  IRE:
+    - name: Naas
+      abbrev: Naas2
+classifications:
+  - name: Rated Stakes
+    abbrev: Rated
+  - name: Chase
+    remove: true
+`
+
+	tests := map[string]struct {
+		input       string
+		format      horserace.FileFormat
+		expectedErr bool
+	}{
+		"load: unknown format":   {input: jsonFile, expectedErr: true},
+		"load: invalid JSON":     {input: "{", format: horserace.FileFormat_JSON, expectedErr: true},
+		"load: invalid YAML":     {input: "tracks: [", format: horserace.FileFormat_YAML, expectedErr: true},
+		"load: malformed YAML":   {input: "0: [:!00 \xef", format: horserace.FileFormat_YAML, expectedErr: true},
+		"load: malformed tag":    {input: "tracks: !00 \xef", format: horserace.FileFormat_YAML, expectedErr: true},
+		"load: unknown country":  {input: `{"tracks": {"DE": [{"name": "Koln", "abbrev": "Koln"}]}}`, format: horserace.FileFormat_JSON, expectedErr: true},
+		"load: abbrev taken":     {input: `{"tracks": {"UK": [{"name": "Test Downs", "abbrev": "York"}]}}`, format: horserace.FileFormat_JSON, expectedErr: true},
+		"load: no abbrev":        {input: `{"tracks": {"UK": [{"name": "Test Downs"}]}}`, format: horserace.FileFormat_JSON, expectedErr: true},
+		"load: no class abbrev":  {input: `{"classifications": [{"name": "Bumper"}]}`, format: horserace.FileFormat_JSON, expectedErr: true},
+		"load: no class name":    {input: `{"classifications": [{"abbrev": "Bump"}]}`, format: horserace.FileFormat_JSON, expectedErr: true},
+		"load: empty YAML":       {input: "", format: horserace.FileFormat_YAML},
+		"load: blank YAML":       {input: " \n  \n", format: horserace.FileFormat_YAML},
+		"load: empty JSON":       {input: "", format: horserace.FileFormat_JSON},
+		"load: blank JSON":       {input: " \n  \n", format: horserace.FileFormat_JSON},
+		"load: valid JSON":       {input: jsonFile, format: horserace.FileFormat_JSON},
+		"load: valid YAML":       {input: yamlFile, format: horserace.FileFormat_YAML},
+		"load: duplicate abbrev": {input: `{"tracks": {"UK": [{"name": "A", "abbrev": "X"}, {"name": "B", "abbrev": "X"}]}}`, format: horserace.FileFormat_JSON, expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := horserace.NewRegistry()
+
+			var errBool bool
+			var errMsg string
+			err := r.Load(strings.NewReader(test.input), test.format)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+			require.NoError(t, r.Validate())
+
+			loaded := !test.expectedErr && strings.TrimSpace(test.input) != ""
+
+			_, err = r.TrackName(horserace.Country_UK, "Folk")
+			assert.Equal(t, loaded, err != nil, "Folkstone removed")
+			_, err = r.Racecourse(horserace.Country_UK, "Folkstone")
+			assert.Equal(t, loaded, err != nil, "Folkstone racecourse removed")
+
+			track, err := r.TrackName(horserace.Country_UK, "TestD")
+			assert.Equal(t, loaded, err == nil, "Test Downs added")
+			if loaded {
+				assert.Equal(t, "Test Downs", track)
+
+				rc, err := r.Racecourse(horserace.Country_IRE, "Naas")
+				require.NoError(t, err)
+				assert.Equal(t, "Naas2", rc.Abbrev)
+
+				classes, err := r.Classifications("Hcap")
+				require.NoError(t, err)
+				assert.Equal(t, []string{"Handicap", "Showcase Handicap"}, classes)
+
+				abbrev, err := r.ClassificationAbbrev("Rated Stakes")
+				require.NoError(t, err)
+				assert.Equal(t, "Rated", abbrev)
+
+				_, err = r.ClassificationAbbrev("Chase")
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	// The default registry is not changed by other registries
+	_, err := horserace.GetTrackNameFromAbbrev(horserace.Country_UK, "Folk")
+	assert.NoError(t, err)
+}
+
+func TestRegistryLoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	yamlPath := filepath.Join(dir, "tracks.yml")
+	require.NoError(t, ioutil.WriteFile(yamlPath, []byte("tracks:\n  GB:\n    - name: Test Downs\n      abbrev: TestD\n"), 0600))
+
+	txtPath := filepath.Join(dir, "tracks.txt")
+	require.NoError(t, ioutil.WriteFile(txtPath, []byte(""), 0600))
+
+	r := horserace.NewRegistry()
+	assert.Error(t, r.LoadFile(txtPath))
+	assert.Error(t, r.LoadFile(filepath.Join(dir, "missing.json")))
+	require.NoError(t, r.LoadFile(yamlPath))
+
+	abbrev, err := r.TrackAbbrev(horserace.Country_UK, "Test Downs")
+	require.NoError(t, err)
+	assert.Equal(t, "TestD", abbrev)
+	assert.Equal(t, "TestD", r.Tracks(horserace.Country_UK)["Test Downs"])
+}
+
+func TestRegistryLoadClassificationsTyped(t *testing.T) {
+	r := horserace.NewRegistry()
+	input := `{"classifications": [{"name": "Hunters", "abbrev": "HntCh"}, {"name": "Rated Stakes", "abbrev": "Rated"},
+		{"name": "Novice", "remove": true}, {"name": "Bumper", "abbrev": "Bump"}]}`
+	require.NoError(t, r.Load(strings.NewReader(input), horserace.FileFormat_JSON))
+
+	classes, err := r.ParseClassificationAbbrev("Hcap")
+	require.NoError(t, err)
+	assert.Equal(t, []horserace.Classification{horserace.Classification_Handicap, horserace.Classification_ShowcaseHandicap}, classes)
+
+	classes, err = r.ParseClassificationAbbrev("Rated")
+	require.NoError(t, err)
+	assert.Equal(t, []horserace.Classification{horserace.Classification_RatedStakes}, classes)
+
+	_, err = r.ParseClassificationAbbrev("Hunt")
+	assert.Error(t, err, "old abbreviation")
+	_, err = r.ParseClassificationAbbrev("Nov")
+	assert.Error(t, err, "removed classification")
+	_, err = r.ParseClassificationAbbrev("Bump")
+	assert.Error(t, err, "classification that is not a Classification")
+
+	mn, err := r.ParseMarketName("3m HntCh Nov Rated")
+	require.NoError(t, err)
+	assert.Equal(t, []horserace.Classification{horserace.Classification_Hunters, horserace.Classification_RatedStakes}, mn.Classifications)
+	assert.Equal(t, []string{"Nov"}, mn.UnknownTokens)
+
+	category, err := mn.Category(horserace.DefaultCategoryThresholds)
+	require.NoError(t, err)
+	assert.Equal(t, horserace.RaceCategory{RaceType: horserace.RaceType_Chase, DistanceCategory: horserace.DistanceCategory_Staying}, category)
+
+	// the default registry is left untouched
+	assert.Equal(t, "Hunt", horserace.Classification(horserace.Classification_Hunters).Abbrev())
+}
@@ -0,0 +1,142 @@
+package horserace
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// MinTrackConfidence is the minimum confidence a fuzzy candidate needs to be returned by ResolveTrack.
+const MinTrackConfidence = 0.6
+
+// abbrevConfidence is the confidence given to a track whose betfair abbreviation matches the name.
+const abbrevConfidence = 0.9
+
+// TrackMatch represents a track candidate for a name.
+type TrackMatch struct {
+	// Name of the track.
+	Name string
+	// Betfair abbreviation of the track.
+	Abbrev string
+	// Confidence represents how close the track is to the name, between 0 and 1.
+	// 1 means the name matches the track name or one of its aliases, once normalised.
+	Confidence float64
+}
+
+// ResolveTrack returns the tracks in the default registry that match the given name, see Registry.ResolveTrack.
+func ResolveTrack(country Country, name string) ([]TrackMatch, error) {
+	return defaultRegistry.ResolveTrack(country, name)
+}
+
+// ResolveTrack returns the tracks that match the given name, starting with the most likely one.
+// Names are compared ignoring case, whitespace, punctuation and all-weather suffixes such as "(AW)",
+// and aliases (e.g. "Kempton Park" for Kempton) and betfair abbreviations are recognised.
+// Other tracks are ranked by edit distance and only returned if their confidence is at least MinTrackConfidence.
+// An error is returned if no track matches the name.
+func (r *Registry) ResolveTrack(country Country, name string) ([]TrackMatch, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tracks, ok := r.trackToAbbrev[country]
+	if !ok {
+		return nil, fmt.Errorf("country [%s] not supported", country)
+	}
+
+	normalised := normaliseTrackName(name)
+	if normalised == "" {
+		return nil, fmt.Errorf("track name cannot be empty")
+	}
+
+	confidences := map[string]float64{}
+	for track, abbrev := range tracks {
+		confidence := trackSimilarity(normalised, normaliseTrackName(track))
+		if normalised == normaliseTrackName(abbrev) && confidence < abbrevConfidence {
+			confidence = abbrevConfidence
+		}
+		confidences[track] = confidence
+	}
+
+	for alias, track := range r.aliases[country] {
+		if confidence := trackSimilarity(normalised, alias); confidence > confidences[track] {
+			confidences[track] = confidence
+		}
+	}
+
+	matches := []TrackMatch{}
+	for track, confidence := range confidences {
+		if confidence >= MinTrackConfidence {
+			matches = append(matches, TrackMatch{Name: track, Abbrev: tracks[track], Confidence: confidence})
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("couldn't find track matching [%s] in country [%s]", name, country)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Confidence != matches[j].Confidence {
+			return matches[i].Confidence > matches[j].Confidence
+		}
+		return matches[i].Name < matches[j].Name
+	})
+	return matches, nil
+}
+
+// normaliseTrackName returns the track name in lower case, with punctuation replaced by a single space
+// and without a trailing all-weather suffix.
+func normaliseTrackName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	if len(words) > 1 && words[len(words)-1] == "aw" {
+		words = words[:len(words)-1]
+	}
+	return strings.Join(words, " ")
+}
+
+// trackSimilarity returns the similarity between two normalised track names, between 0 and 1,
+// based on the Levenshtein distance.
+func trackSimilarity(a string, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	maxLen := len(ra)
+	if len(rb) > maxLen {
+		maxLen = len(rb)
+	}
+
+	if maxLen == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(maxLen)
+}
+
+// levenshtein returns the minimum number of single character insertions, deletions or substitutions
+// needed to change a into b.
+func levenshtein(a []rune, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = prev[j-1] + cost
+			if prev[j]+1 < curr[j] {
+				curr[j] = prev[j] + 1
+			}
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,83 @@
+package horserace_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/horserace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveTrack(t *testing.T) {
+	tests := map[string]struct {
+		country            horserace.Country
+		name               string
+		expectedTrack      string
+		expectedAbbrev     string
+		expectedConfidence float64
+		expectedErr        bool
+	}{
+		"resolve: exact":            {country: horserace.Country_UK, name: "Kempton", expectedTrack: "Kempton", expectedAbbrev: "Kemp", expectedConfidence: 1},
+		"resolve: lower case":       {country: horserace.Country_UK, name: "kempton", expectedTrack: "Kempton", expectedAbbrev: "Kemp", expectedConfidence: 1},
+		"resolve: alias":            {country: horserace.Country_UK, name: "Kempton Park", expectedTrack: "Kempton", expectedAbbrev: "Kemp", expectedConfidence: 1},
+		"resolve: alias city":       {country: horserace.Country_UK, name: "Chelmsford", expectedTrack: "Chelmsford City", expectedAbbrev: "ChelmC", expectedConfidence: 1},
+		"resolve: all-weather":      {country: horserace.Country_UK, name: "Newcastle (AW)", expectedTrack: "Newcastle", expectedAbbrev: "Newc", expectedConfidence: 1},
+		"resolve: punctuation":      {country: horserace.Country_UK, name: "  stratford-on-AVON ", expectedTrack: "Stratford", expectedAbbrev: "Strat", expectedConfidence: 1},
+		"resolve: whitespace":       {country: horserace.Country_IRE, name: "Down   Royal ", expectedTrack: "Down Royal", expectedAbbrev: "DownR", expectedConfidence: 1},
+		"resolve: abbreviation":     {country: horserace.Country_UK, name: "Kemp", expectedTrack: "Kempton", expectedAbbrev: "Kemp", expectedConfidence: 0.9},
+		"resolve: typo":             {country: horserace.Country_UK, name: "Cheltenam", expectedTrack: "Cheltenham", expectedAbbrev: "Chelt", expectedConfidence: 0.9},
+		"resolve: empty":            {country: horserace.Country_UK, name: " (AW) ", expectedErr: true},
+		"resolve: no match":         {country: horserace.Country_UK, name: "Flemington", expectedErr: true},
+		"resolve: unknown country":  {country: 0, name: "Kempton", expectedErr: true},
+		"resolve: wrong country":    {country: horserace.Country_IRE, name: "Kempton Park", expectedErr: true},
+		"resolve: irish alias":      {country: horserace.Country_IRE, name: "Curragh", expectedTrack: "The Curragh", expectedAbbrev: "Curr", expectedConfidence: 1},
+		"resolve: folkestone alias": {country: horserace.Country_UK, name: "Folkestone", expectedTrack: "Folkstone", expectedAbbrev: "Folk", expectedConfidence: 1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			matches, err := horserace.ResolveTrack(test.country, test.name)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			require.NotEmpty(t, matches)
+			assert.Equal(t, test.expectedTrack, matches[0].Name)
+			assert.Equal(t, test.expectedAbbrev, matches[0].Abbrev)
+			assert.InDelta(t, test.expectedConfidence, matches[0].Confidence, 1e-9)
+
+			for i := 1; i < len(matches); i++ {
+				assert.GreaterOrEqual(t, matches[i-1].Confidence, matches[i].Confidence)
+				assert.GreaterOrEqual(t, matches[i].Confidence, horserace.MinTrackConfidence)
+			}
+		})
+	}
+}
+
+func TestRegistryResolveTrackLoadedAliases(t *testing.T) {
+	r := horserace.NewRegistry()
+	input := `{"tracks": {"UK": [{"name": "Newcastle", "abbrev": "Newc", "aliases": ["Gosforth Park"]}]}}`
+	require.NoError(t, r.Load(strings.NewReader(input), horserace.FileFormat_JSON))
+
+	matches, err := r.ResolveTrack(horserace.Country_UK, "gosforth park")
+	require.NoError(t, err)
+	assert.Equal(t, "Newcastle", matches[0].Name)
+	assert.Equal(t, 1.0, matches[0].Confidence)
+
+	input = `{"tracks": {"UK": [{"name": "Kempton", "remove": true}]}}`
+	require.NoError(t, r.Load(strings.NewReader(input), horserace.FileFormat_JSON))
+
+	_, err = r.ResolveTrack(horserace.Country_UK, "Kempton Park")
+	assert.Error(t, err)
+}
@@ -0,0 +1,94 @@
+package horserace
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Runner represents the parsed name of a runner in a horse race market.
+type Runner struct {
+	// Cloth number of the runner. Zero if the runner name doesn't have one.
+	ClothNumber int
+	// Name of the horse, without the cloth number, the country suffix and the non-runner marker.
+	Name string
+	// Country of breeding suffix in upper case, e.g. "GB", "IRE" or "USA". Empty if the runner name doesn't have one.
+	// Betfair uses the racing codes, which are not always ISO codes, so it's not parsed into Country.
+	BredIn string
+	// NonRunner is true if the runner name has a non-runner marker, e.g. "NR".
+	NonRunner bool
+}
+
+// clothNumberRgx matches the cloth number at the start of a runner name, e.g. "1. ".
+var clothNumberRgx = regexp.MustCompile(`^(\d+)\.\s*`)
+
+// bredInRgx matches the country of breeding suffix at the end of a runner name, e.g. " (GB)".
+var bredInRgx = regexp.MustCompile(`\s*\(([A-Za-z]{2,3})\)$`)
+
+// nonRunnerRgx matches the non-runner marker at the end of a runner name, e.g. " NR" or " (Non Runner)".
+var nonRunnerRgx = regexp.MustCompile(`(?i)(\s+(NR|N/R|Non[\s-]?Runner)|\s*\(\s*(NR|N/R|Non[\s-]?Runner)\s*\))$`)
+
+// accentReplacer replaces the accented letters commonly found in horse names by their base letters.
+var accentReplacer = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ä", "a", "ã", "a", "å", "a",
+	"ç", "c",
+	"è", "e", "é", "e", "ê", "e", "ë", "e",
+	"ì", "i", "í", "i", "î", "i", "ï", "i",
+	"ñ", "n",
+	"ò", "o", "ó", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o",
+	"ù", "u", "ú", "u", "û", "u", "ü", "u",
+	"ý", "y", "ÿ", "y",
+	"ß", "ss", "æ", "ae", "œ", "oe",
+)
+
+// ParseRunnerName parses the name of a runner (as returned by the betfair API), e.g. "1. Frankel" or "Enable (GB)".
+// The non-runner marker, the country of breeding suffix and the cloth number are removed in that order.
+func ParseRunnerName(runnerName string) (r Runner, err error) {
+	name := strings.TrimSpace(runnerName)
+
+	if loc := nonRunnerRgx.FindStringIndex(name); loc != nil && loc[0] > 0 {
+		r.NonRunner = true
+		name = name[:loc[0]]
+	}
+
+	if match := bredInRgx.FindStringSubmatchIndex(name); match != nil {
+		r.BredIn = strings.ToUpper(name[match[2]:match[3]])
+		name = name[:match[0]]
+	}
+
+	if match := clothNumberRgx.FindStringSubmatch(name); match != nil {
+		number, err := strconv.Atoi(match[1])
+		if err != nil || number == 0 {
+			return Runner{}, fmt.Errorf("couldn't parse cloth number [%s]", match[1])
+		}
+		r.ClothNumber = number
+		name = name[len(match[0]):]
+	}
+
+	r.Name = strings.Join(strings.Fields(name), " ")
+	if r.Name == "" {
+		return Runner{}, fmt.Errorf("runner name [%s] doesn't have a horse name", runnerName)
+	}
+	return r, nil
+}
+
+// NormalisedName returns the horse name normalised for matching across data sources, see NormaliseHorseName.
+func (r Runner) NormalisedName() string {
+	return NormaliseHorseName(r.Name)
+}
+
+// NormaliseHorseName returns the horse name in lower case, without accents and apostrophes,
+// and with other punctuation replaced by a single space, e.g. "dancing brave" for "Dancing-Brave"
+// and "dont forget" for "Don't Forget".
+// The name must not have a cloth number, a country suffix or a non-runner marker, see ParseRunnerName.
+func NormaliseHorseName(name string) string {
+	name = accentReplacer.Replace(strings.ToLower(name))
+	name = strings.NewReplacer("'", "", "’", "", "`", "").Replace(name)
+
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,87 @@
+package horserace_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gustavooferreira/bfutils/horserace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRunnerName(t *testing.T) {
+	tests := map[string]struct {
+		runnerName     string
+		expectedRunner horserace.Runner
+		expectedErr    bool
+	}{
+		"parse: name only":         {runnerName: "Frankel", expectedRunner: horserace.Runner{Name: "Frankel"}},
+		"parse: cloth number":      {runnerName: "1. Frankel", expectedRunner: horserace.Runner{ClothNumber: 1, Name: "Frankel"}},
+		"parse: no space":          {runnerName: "12.Sea The Stars", expectedRunner: horserace.Runner{ClothNumber: 12, Name: "Sea The Stars"}},
+		"parse: country":           {runnerName: "Enable (GB)", expectedRunner: horserace.Runner{Name: "Enable", BredIn: "GB"}},
+		"parse: three letters":     {runnerName: "Galileo (Ire)", expectedRunner: horserace.Runner{Name: "Galileo", BredIn: "IRE"}},
+		"parse: everything":        {runnerName: " 3.  Sea  The Moon (GER) NR ", expectedRunner: horserace.Runner{ClothNumber: 3, Name: "Sea The Moon", BredIn: "GER", NonRunner: true}},
+		"parse: non runner":        {runnerName: "Frankel (Non Runner)", expectedRunner: horserace.Runner{Name: "Frankel", NonRunner: true}},
+		"parse: non runner slash":  {runnerName: "2. Frankel n/r", expectedRunner: horserace.Runner{ClothNumber: 2, Name: "Frankel", NonRunner: true}},
+		"parse: non runner hyphen": {runnerName: "Frankel Non-Runner", expectedRunner: horserace.Runner{Name: "Frankel", NonRunner: true}},
+		"parse: name ending in nr": {runnerName: "Winnr", expectedRunner: horserace.Runner{Name: "Winnr"}},
+		"parse: number in name":    {runnerName: "Mr 2000 (USA)", expectedRunner: horserace.Runner{Name: "Mr 2000", BredIn: "USA"}},
+		"parse: zero cloth number": {runnerName: "0. Frankel", expectedErr: true},
+		"parse: no name":           {runnerName: "1. (GB)", expectedErr: true},
+		"parse: empty":             {runnerName: "", expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			value, err := horserace.ParseRunnerName(test.runnerName)
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			if test.expectedErr {
+				return
+			}
+
+			assert.Equal(t, test.expectedRunner, value)
+		})
+	}
+}
+
+func TestNormaliseHorseName(t *testing.T) {
+	tests := map[string]struct {
+		horseName    string
+		expectedName string
+	}{
+		"normalise: case":        {horseName: "FRANKEL", expectedName: "frankel"},
+		"normalise: whitespace":  {horseName: "  Sea   The Stars ", expectedName: "sea the stars"},
+		"normalise: hyphen":      {horseName: "Dancing-Brave", expectedName: "dancing brave"},
+		"normalise: apostrophe":  {horseName: "Don't Forget", expectedName: "dont forget"},
+		"normalise: curly quote": {horseName: "Don’t Forget", expectedName: "dont forget"},
+		"normalise: accents":     {horseName: "Trêve", expectedName: "treve"},
+		"normalise: german":      {horseName: "Fußball", expectedName: "fussball"},
+		"normalise: dots":        {horseName: "St. Nicholas Abbey", expectedName: "st nicholas abbey"},
+		"normalise: empty":       {horseName: "", expectedName: ""},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			value := horserace.NormaliseHorseName(test.horseName)
+			assert.Equal(t, test.expectedName, value)
+		})
+	}
+}
+
+func TestRunnerNormalisedName(t *testing.T) {
+	a, err := horserace.ParseRunnerName("1. Trêve (FR)")
+	require.NoError(t, err)
+
+	b, err := horserace.ParseRunnerName("TREVE")
+	require.NoError(t, err)
+
+	assert.Equal(t, a.NormalisedName(), b.NormalisedName())
+}
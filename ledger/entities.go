@@ -0,0 +1,62 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/gustavooferreira/bfutils/betting"
+)
+
+// Record represents a matched bet, as exported by the exchange in the cleared orders report.
+type Record struct {
+	// Bet ID given by the exchange.
+	BetID string
+	// Market ID the bet was placed on.
+	MarketID string
+	// Selection ID the bet was placed on.
+	SelectionID int64
+	// Handicap of the selection, only used by Asian handicap markets.
+	Handicap float64
+	// Bet type: Back or Lay.
+	Side betting.BetType
+	// Order type used to place the bet.
+	OrderType betting.OrderType
+	// Odd requested when placing the bet.
+	PriceRequested float64
+	// Average odd matched.
+	PriceMatched float64
+	// Amount matched (backer's stake).
+	SizeMatched float64
+	// PriceReduced is true if the odd matched was reduced because of a non-runner.
+	PriceReduced bool
+	// Date the bet was placed.
+	PlacedDate time.Time
+	// Date the bet was last matched.
+	MatchedDate time.Time
+}
+
+// Bet returns the record as a bet, with its P&L computed as if it was the only bet in the selection.
+// The cleared orders report doesn't include the reduction factor, and it can't be derived from the
+// odd requested as the bet might have been matched at a better odd. Therefore, reduced bets are
+// flagged with PriceReduced and their ReductionFactor is left as zero.
+func (r Record) Bet() betting.Bet {
+	bet := betting.NewBet(r.Side, r.PriceMatched, r.SizeMatched)
+	bet.OrderType = r.OrderType
+	bet.PriceReduced = r.PriceReduced
+	return bet
+}
+
+// Selection represents a selection, at a given handicap, with the bets recorded on it.
+type Selection struct {
+	// Handicap of the selection, only used by Asian handicap markets.
+	Handicap float64
+	// Selection with the bets recorded on it.
+	Selection betting.Selection
+}
+
+// MarketSelections represents the selections of a market with the bets recorded on them.
+type MarketSelections struct {
+	// Market ID.
+	MarketID string
+	// Selections with bets, in the order they first appear in the records.
+	Selections []Selection
+}
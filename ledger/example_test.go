@@ -0,0 +1,41 @@
+package ledger_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/gustavooferreira/bfutils/ledger"
+)
+
+// This example reads bets exported in CSV and computes the bet needed to green book each selection.
+func Example_a() {
+	input := `bet_id,market_id,selection_id,handicap,side,order_type,price_requested,price_matched,size_matched,price_reduced,placed_date,matched_date
+1,1.179082386,12345,0,BACK,LIMIT,4,4,10,false,2021-01-17T14:00:05Z,2021-01-17T14:00:05Z
+2,1.179082386,12345,0,LAY,LIMIT,3.5,3.5,5,false,2021-01-17T14:02:10Z,2021-01-17T14:02:11Z
+`
+
+	records, err := ledger.ReadCSV(strings.NewReader(input))
+	if err != nil {
+		panic(err)
+	}
+
+	for _, market := range ledger.GroupByMarket(records) {
+		for _, s := range market.Selections {
+			selection := s.Selection
+			selection.CurrentBackOdd = 3
+			selection.CurrentLayOdd = 3.05
+
+			bet, err := betting.GreenBookSelection(selection)
+			if err != nil {
+				panic(err)
+			}
+
+			fmt.Printf("Market [%s] selection [%d]: put a {%s} bet at {%.2f} for £%.2f.\n",
+				market.MarketID, selection.ID, bet.Type, bet.Odd, bet.Amount)
+		}
+	}
+
+	// Output:
+	// Market [1.179082386] selection [12345]: put a {Lay} bet at {3.05} for £7.38.
+}
@@ -0,0 +1,390 @@
+// Package ledger provides functions to read and write matched bets in CSV and JSON, and to group
+// them into selections that can be used with the betting package.
+// The JSON format is the one used by the exchange in the cleared orders report.
+package ledger
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gustavooferreira/bfutils"
+	"github.com/gustavooferreira/bfutils/betting"
+)
+
+// csvHeader holds the columns of the CSV format, in order.
+var csvHeader = []string{
+	"bet_id", "market_id", "selection_id", "handicap", "side", "order_type", "price_requested",
+	"price_matched", "size_matched", "price_reduced", "placed_date", "matched_date",
+}
+
+// ReadCSV reads records in CSV format. The first line must be the header.
+func ReadCSV(r io.Reader) ([]Record, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(csvHeader)
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("missing header")
+	} else if err != nil {
+		return nil, err
+	}
+
+	for i, column := range csvHeader {
+		if header[i] != column {
+			return nil, fmt.Errorf("expected column [%s] but got [%s]", column, header[i])
+		}
+	}
+
+	records := []Record{}
+
+	for i := 1; ; i++ {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		record, err := parseCSVRecord(fields)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", i, err)
+		}
+
+		if err := checkRecord(record); err != nil {
+			return nil, fmt.Errorf("record %d: %w", i, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// WriteCSV writes records in CSV format, including the header.
+func WriteCSV(w io.Writer, records []Record) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		side, err := formatSide(record.Side)
+		if err != nil {
+			return err
+		}
+
+		orderType, err := formatOrderType(record.OrderType)
+		if err != nil {
+			return err
+		}
+
+		fields := []string{
+			record.BetID,
+			record.MarketID,
+			strconv.FormatInt(record.SelectionID, 10),
+			formatFloat(record.Handicap),
+			side,
+			orderType,
+			formatFloat(record.PriceRequested),
+			formatFloat(record.PriceMatched),
+			formatFloat(record.SizeMatched),
+			strconv.FormatBool(record.PriceReduced),
+			formatTime(record.PlacedDate),
+			formatTime(record.MatchedDate),
+		}
+
+		if err := writer.Write(fields); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// clearedOrders represents the cleared orders report.
+type clearedOrders struct {
+	ClearedOrders []clearedOrder `json:"clearedOrders"`
+	MoreAvailable bool           `json:"moreAvailable"`
+}
+
+// clearedOrder represents an order in the cleared orders report.
+type clearedOrder struct {
+	BetID           string  `json:"betId"`
+	MarketID        string  `json:"marketId"`
+	SelectionID     int64   `json:"selectionId"`
+	Handicap        float64 `json:"handicap"`
+	Side            string  `json:"side"`
+	OrderType       string  `json:"orderType"`
+	PriceRequested  float64 `json:"priceRequested,omitempty"`
+	PriceMatched    float64 `json:"priceMatched"`
+	SizeSettled     float64 `json:"sizeSettled"`
+	PriceReduced    bool    `json:"priceReduced"`
+	PlacedDate      string  `json:"placedDate,omitempty"`
+	LastMatchedDate string  `json:"lastMatchedDate,omitempty"`
+}
+
+// ReadJSON reads records in the cleared orders report JSON format.
+func ReadJSON(r io.Reader) ([]Record, error) {
+	report := clearedOrders{}
+	if err := json.NewDecoder(r).Decode(&report); err != nil {
+		return nil, err
+	}
+
+	records := []Record{}
+
+	for i, order := range report.ClearedOrders {
+		side, err := parseSide(order.Side)
+		if err != nil {
+			return nil, fmt.Errorf("order %d: %w", i, err)
+		}
+
+		orderType, err := parseOrderType(order.OrderType)
+		if err != nil {
+			return nil, fmt.Errorf("order %d: %w", i, err)
+		}
+
+		placedDate, err := parseTime(order.PlacedDate)
+		if err != nil {
+			return nil, fmt.Errorf("order %d: %w", i, err)
+		}
+
+		matchedDate, err := parseTime(order.LastMatchedDate)
+		if err != nil {
+			return nil, fmt.Errorf("order %d: %w", i, err)
+		}
+
+		record := Record{
+			BetID:          order.BetID,
+			MarketID:       order.MarketID,
+			SelectionID:    order.SelectionID,
+			Handicap:       order.Handicap,
+			Side:           side,
+			OrderType:      orderType,
+			PriceRequested: order.PriceRequested,
+			PriceMatched:   order.PriceMatched,
+			SizeMatched:    order.SizeSettled,
+			PriceReduced:   order.PriceReduced,
+			PlacedDate:     placedDate,
+			MatchedDate:    matchedDate,
+		}
+
+		if err := checkRecord(record); err != nil {
+			return nil, fmt.Errorf("order %d: %w", i, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// WriteJSON writes records in the cleared orders report JSON format.
+func WriteJSON(w io.Writer, records []Record) error {
+	report := clearedOrders{ClearedOrders: []clearedOrder{}}
+
+	for _, record := range records {
+		side, err := formatSide(record.Side)
+		if err != nil {
+			return err
+		}
+
+		orderType, err := formatOrderType(record.OrderType)
+		if err != nil {
+			return err
+		}
+
+		report.ClearedOrders = append(report.ClearedOrders, clearedOrder{
+			BetID:           record.BetID,
+			MarketID:        record.MarketID,
+			SelectionID:     record.SelectionID,
+			Handicap:        record.Handicap,
+			Side:            side,
+			OrderType:       orderType,
+			PriceRequested:  record.PriceRequested,
+			PriceMatched:    record.PriceMatched,
+			SizeSettled:     record.SizeMatched,
+			PriceReduced:    record.PriceReduced,
+			PlacedDate:      formatTime(record.PlacedDate),
+			LastMatchedDate: formatTime(record.MatchedDate),
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// GroupByMarket groups the records into selections for each market.
+// Markets and selections are returned in the order they first appear in the records.
+// Records on the same selection with different handicaps are grouped into different selections,
+// told apart by their handicap.
+func GroupByMarket(records []Record) []MarketSelections {
+	type selectionKey struct {
+		selectionID int64
+		handicap    float64
+	}
+
+	markets := []MarketSelections{}
+	marketIndex := map[string]int{}
+	selectionIndex := map[string]map[selectionKey]int{}
+
+	for _, record := range records {
+		mi, ok := marketIndex[record.MarketID]
+		if !ok {
+			mi = len(markets)
+			marketIndex[record.MarketID] = mi
+			selectionIndex[record.MarketID] = map[selectionKey]int{}
+			markets = append(markets, MarketSelections{MarketID: record.MarketID})
+		}
+
+		key := selectionKey{selectionID: record.SelectionID, handicap: record.Handicap}
+		si, ok := selectionIndex[record.MarketID][key]
+		if !ok {
+			si = len(markets[mi].Selections)
+			selectionIndex[record.MarketID][key] = si
+			markets[mi].Selections = append(markets[mi].Selections,
+				Selection{Handicap: record.Handicap, Selection: betting.Selection{ID: record.SelectionID}})
+		}
+
+		selection := &markets[mi].Selections[si].Selection
+		selection.Bets = append(selection.Bets, record.Bet())
+	}
+
+	return markets
+}
+
+// checkRecord returns an error if the size matched is not positive or the odd matched is not valid.
+// Odds matched at the starting price, or reduced because of a non-runner, only need to be within
+// the trading range.
+func checkRecord(record Record) error {
+	if record.SizeMatched <= 0 {
+		return fmt.Errorf("size matched [%f] must be positive", record.SizeMatched)
+	}
+
+	if record.PriceReduced || record.OrderType != betting.OrderType_Limit {
+		if !bfutils.IsOddWithinBoundaries(record.PriceMatched) {
+			return fmt.Errorf("price matched [%f] is outside of trading range", record.PriceMatched)
+		}
+		return nil
+	}
+
+	if match, _, err := bfutils.FindOdd(record.PriceMatched); err != nil || !match {
+		return fmt.Errorf("price matched [%f] does not exist in the ladder", record.PriceMatched)
+	}
+	return nil
+}
+
+func parseCSVRecord(fields []string) (record Record, err error) {
+	record.BetID = fields[0]
+	record.MarketID = fields[1]
+
+	if record.SelectionID, err = strconv.ParseInt(fields[2], 10, 64); err != nil {
+		return record, fmt.Errorf("invalid selection ID [%s]", fields[2])
+	}
+
+	floats := []*float64{&record.Handicap, &record.PriceRequested, &record.PriceMatched, &record.SizeMatched}
+	for i, index := range []int{3, 6, 7, 8} {
+		if *floats[i], err = strconv.ParseFloat(fields[index], 64); err != nil {
+			return record, fmt.Errorf("invalid %s [%s]", csvHeader[index], fields[index])
+		}
+	}
+
+	if record.Side, err = parseSide(fields[4]); err != nil {
+		return record, err
+	}
+
+	if record.OrderType, err = parseOrderType(fields[5]); err != nil {
+		return record, err
+	}
+
+	if record.PriceReduced, err = strconv.ParseBool(fields[9]); err != nil {
+		return record, fmt.Errorf("invalid price_reduced [%s]", fields[9])
+	}
+
+	if record.PlacedDate, err = parseTime(fields[10]); err != nil {
+		return record, err
+	}
+
+	if record.MatchedDate, err = parseTime(fields[11]); err != nil {
+		return record, err
+	}
+
+	return record, nil
+}
+
+var sides = map[string]betting.BetType{
+	"BACK": betting.BetType_Back,
+	"LAY":  betting.BetType_Lay,
+}
+
+var orderTypes = map[string]betting.OrderType{
+	"LIMIT":           betting.OrderType_Limit,
+	"LIMIT_ON_CLOSE":  betting.OrderType_LimitOnClose,
+	"MARKET_ON_CLOSE": betting.OrderType_MarketOnClose,
+}
+
+func parseSide(s string) (betting.BetType, error) {
+	side, ok := sides[s]
+	if !ok {
+		return 0, fmt.Errorf("unknown side [%s]", s)
+	}
+	return side, nil
+}
+
+func formatSide(side betting.BetType) (string, error) {
+	for s, bt := range sides {
+		if bt == side {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("unknown bet type")
+}
+
+func parseOrderType(s string) (betting.OrderType, error) {
+	orderType, ok := orderTypes[s]
+	if !ok {
+		return 0, fmt.Errorf("unknown order type [%s]", s)
+	}
+	return orderType, nil
+}
+
+func formatOrderType(orderType betting.OrderType) (string, error) {
+	for s, ot := range orderTypes {
+		if ot == orderType {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("unknown order type")
+}
+
+// formatFloat formats a float with the minimum number of digits needed to read it back exactly.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// formatTime formats a time in RFC3339 with nanoseconds. The zero time is formatted as an empty string.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// parseTime parses a time in RFC3339. An empty string is parsed as the zero time.
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date [%s]", s)
+	}
+	return t, nil
+}
@@ -0,0 +1,259 @@
+package ledger_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gustavooferreira/bfutils/betting"
+	"github.com/gustavooferreira/bfutils/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const csvHeader = "bet_id,market_id,selection_id,handicap,side,order_type,price_requested,price_matched,size_matched,price_reduced,placed_date,matched_date\n"
+
+var testRecords = []ledger.Record{
+	{
+		BetID:          "228302937743",
+		MarketID:       "1.179082386",
+		SelectionID:    12345,
+		Side:           betting.BetType_Back,
+		OrderType:      betting.OrderType_Limit,
+		PriceRequested: 4,
+		PriceMatched:   4.1,
+		SizeMatched:    12.345678901234567,
+		PlacedDate:     time.Date(2021, 1, 17, 14, 0, 5, 123456789, time.UTC),
+		MatchedDate:    time.Date(2021, 1, 17, 14, 0, 6, 0, time.FixedZone("", 3600)),
+	},
+	{
+		BetID:        "228302937744",
+		MarketID:     "1.179082386",
+		SelectionID:  67890,
+		Handicap:     -0.5,
+		Side:         betting.BetType_Lay,
+		OrderType:    betting.OrderType_MarketOnClose,
+		PriceMatched: 7.5,
+		SizeMatched:  10,
+		PriceReduced: true,
+		PlacedDate:   time.Date(2021, 1, 17, 14, 1, 0, 0, time.UTC),
+	},
+}
+
+func TestReadCSV(t *testing.T) {
+	tests := map[string]struct {
+		input           string
+		expectedRecords []ledger.Record
+		expectedErr     bool
+	}{
+		"read CSV 1": {input: "", expectedErr: true},
+		"read CSV 2": {input: strings.Replace(csvHeader, "bet_id", "id", 1), expectedErr: true},
+		"read CSV 3": {input: csvHeader + "1,1.2,3,0,BACK,LIMIT,2,2,10,false,,\n", expectedRecords: []ledger.Record{
+			{BetID: "1", MarketID: "1.2", SelectionID: 3, Side: betting.BetType_Back, OrderType: betting.OrderType_Limit,
+				PriceRequested: 2, PriceMatched: 2, SizeMatched: 10},
+		}},
+		"read CSV 4":  {input: csvHeader + "1,1.2,3,0,BUY,LIMIT,2,2,10,false,,\n", expectedErr: true},
+		"read CSV 5":  {input: csvHeader + "1,1.2,3,0,BACK,LIMIT,2,2,10,false,yesterday,\n", expectedErr: true},
+		"read CSV 6":  {input: csvHeader + "1,1.2,x,0,BACK,LIMIT,2,2,10,false,,\n", expectedErr: true},
+		"read CSV 7":  {input: csvHeader + "1,1.2,3,0,BACK,LIMIT,2,2,ten,false,,\n", expectedErr: true},
+		"read CSV 8":  {input: csvHeader + "1,1.2,3,0,BACK,LIMIT,2,2,10\n", expectedErr: true},
+		"read CSV 9":  {input: csvHeader, expectedRecords: []ledger.Record{}},
+		"read CSV 10": {input: csvHeader + "1,1.2,3,0,BACK,LIMIT,2,2,0,false,,\n", expectedErr: true},
+		"read CSV 11": {input: csvHeader + "1,1.2,3,0,BACK,LIMIT,2,2,-5,false,,\n", expectedErr: true},
+		"read CSV 12": {input: csvHeader + "1,1.2,3,0,BACK,LIMIT,3,3.01,10,false,,\n", expectedErr: true},
+		"read CSV 13": {input: csvHeader + "1,1.2,3,0,BACK,LIMIT,3,1001,10,true,,\n", expectedErr: true},
+		"read CSV 14": {input: csvHeader + "1,1.2,3,0,BACK,LIMIT,4,3.01,10,true,,\n", expectedRecords: []ledger.Record{
+			{BetID: "1", MarketID: "1.2", SelectionID: 3, Side: betting.BetType_Back, OrderType: betting.OrderType_Limit,
+				PriceRequested: 4, PriceMatched: 3.01, SizeMatched: 10, PriceReduced: true},
+		}},
+		"read CSV 15": {input: csvHeader + "1,1.2,3,0,LAY,MARKET_ON_CLOSE,0,4.37,5,false,,\n", expectedRecords: []ledger.Record{
+			{BetID: "1", MarketID: "1.2", SelectionID: 3, Side: betting.BetType_Lay, OrderType: betting.OrderType_MarketOnClose,
+				PriceMatched: 4.37, SizeMatched: 5},
+		}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			records, err := ledger.ReadCSV(strings.NewReader(test.input))
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.Equal(t, test.expectedRecords, records)
+		})
+	}
+}
+
+func TestReadJSON(t *testing.T) {
+	tests := map[string]struct {
+		input           string
+		expectedRecords []ledger.Record
+		expectedErr     bool
+	}{
+		"read JSON 1": {input: "", expectedErr: true},
+		"read JSON 2": {input: `{"clearedOrders":[{"betId":"1","side":"BACK","orderType":"LIMIT","placedDate":"now"}]}`, expectedErr: true},
+		"read JSON 3": {input: `{"clearedOrders":[{"betId":"1","side":"BACK","orderType":"FILL_OR_KILL"}]}`, expectedErr: true},
+		"read JSON 4": {
+			input: `{"clearedOrders":[{"eventTypeId":"7","eventId":"30245187","marketId":"1.179082386",` +
+				`"selectionId":12345,"handicap":0.0,"betId":"228302937743","placedDate":"2021-01-17T14:00:05.000Z",` +
+				`"persistenceType":"LAPSE","orderType":"LIMIT","side":"LAY","betOutcome":"WON",` +
+				`"priceRequested":3.5,"settledDate":"2021-01-17T14:20:11.000Z","lastMatchedDate":"2021-01-17T14:00:05.000Z",` +
+				`"betCount":1,"priceMatched":3.5,"priceReduced":false,"sizeSettled":5.0,"profit":5.0}],"moreAvailable":false}`,
+			expectedRecords: []ledger.Record{
+				{BetID: "228302937743", MarketID: "1.179082386", SelectionID: 12345, Side: betting.BetType_Lay,
+					OrderType: betting.OrderType_Limit, PriceRequested: 3.5, PriceMatched: 3.5, SizeMatched: 5,
+					PlacedDate:  time.Date(2021, 1, 17, 14, 0, 5, 0, time.UTC),
+					MatchedDate: time.Date(2021, 1, 17, 14, 0, 5, 0, time.UTC)},
+			},
+		},
+		"read JSON 5": {input: `{"clearedOrders":[{"betId":"1","side":"BACK","orderType":"LIMIT","priceMatched":2,"sizeSettled":0}]}`,
+			expectedErr: true},
+		"read JSON 6": {input: `{"clearedOrders":[{"betId":"1","side":"BACK","orderType":"LIMIT","priceMatched":3.01,"sizeSettled":10}]}`,
+			expectedErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var errBool bool
+			var errMsg string
+			records, err := ledger.ReadJSON(strings.NewReader(test.input))
+			if err != nil {
+				errBool = true
+				errMsg = fmt.Sprintf(" - err: %s", err.Error())
+			}
+
+			require.Equal(t, test.expectedErr, errBool, "error field"+errMsg)
+
+			assert.Equal(t, test.expectedRecords, records)
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := map[string]struct {
+		write func(*bytes.Buffer, []ledger.Record) error
+		read  func(*bytes.Buffer) ([]ledger.Record, error)
+	}{
+		"round trip CSV": {
+			write: func(b *bytes.Buffer, records []ledger.Record) error { return ledger.WriteCSV(b, records) },
+			read:  func(b *bytes.Buffer) ([]ledger.Record, error) { return ledger.ReadCSV(b) },
+		},
+		"round trip JSON": {
+			write: func(b *bytes.Buffer, records []ledger.Record) error { return ledger.WriteJSON(b, records) },
+			read:  func(b *bytes.Buffer) ([]ledger.Record, error) { return ledger.ReadJSON(b) },
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := test.write(buf, testRecords)
+			require.NoError(t, err)
+
+			records, err := test.read(buf)
+			require.NoError(t, err)
+			require.Len(t, records, len(testRecords))
+
+			for i, record := range records {
+				expected := testRecords[i]
+				assert.True(t, expected.PlacedDate.Equal(record.PlacedDate), "placed date field")
+				assert.True(t, expected.MatchedDate.Equal(record.MatchedDate), "matched date field")
+
+				expected.PlacedDate, record.PlacedDate = time.Time{}, time.Time{}
+				expected.MatchedDate, record.MatchedDate = time.Time{}, time.Time{}
+				assert.Equal(t, expected, record)
+			}
+		})
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := ledger.WriteCSV(buf, []ledger.Record{{BetID: "1", MarketID: "1.2", SelectionID: 3}})
+	require.Error(t, err)
+
+	buf.Reset()
+	err = ledger.WriteCSV(buf, testRecords[:1])
+	require.NoError(t, err)
+	assert.Equal(t, csvHeader+
+		"228302937743,1.179082386,12345,0,BACK,LIMIT,4,4.1,12.345678901234567,false,2021-01-17T14:00:05.123456789Z,2021-01-17T14:00:06+01:00\n",
+		buf.String())
+}
+
+func TestGroupByMarket(t *testing.T) {
+	records := []ledger.Record{
+		{MarketID: "1.1", SelectionID: 1, Side: betting.BetType_Back, PriceMatched: 3, SizeMatched: 10},
+		{MarketID: "1.2", SelectionID: 5, Side: betting.BetType_Lay, PriceMatched: 2, SizeMatched: 4},
+		{MarketID: "1.1", SelectionID: 2, Side: betting.BetType_Back, PriceMatched: 5, SizeMatched: 2},
+		{MarketID: "1.1", SelectionID: 1, Side: betting.BetType_Lay, PriceMatched: 2.5, SizeMatched: 10},
+		{MarketID: "1.1", SelectionID: 2, Handicap: 0.5, Side: betting.BetType_Back, PriceMatched: 2, SizeMatched: 1},
+		{MarketID: "1.2", SelectionID: 6, Side: betting.BetType_Back, PriceMatched: 7.5, PriceRequested: 10,
+			PriceReduced: true, SizeMatched: 2},
+	}
+
+	markets := ledger.GroupByMarket(records)
+
+	expected := []ledger.MarketSelections{
+		{MarketID: "1.1", Selections: []ledger.Selection{
+			{Selection: betting.Selection{ID: 1, Bets: []betting.Bet{
+				{Type: betting.BetType_Back, Odd: 3, Amount: 10, WinPL: 20, LosePL: -10},
+				{Type: betting.BetType_Lay, Odd: 2.5, Amount: 10, WinPL: -15, LosePL: 10},
+			}}},
+			{Selection: betting.Selection{ID: 2, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 5, Amount: 2, WinPL: 8, LosePL: -2}}}},
+			{Handicap: 0.5, Selection: betting.Selection{ID: 2, Bets: []betting.Bet{{Type: betting.BetType_Back, Odd: 2, Amount: 1, WinPL: 1, LosePL: -1}}}},
+		}},
+		{MarketID: "1.2", Selections: []ledger.Selection{
+			{Selection: betting.Selection{ID: 5, Bets: []betting.Bet{{Type: betting.BetType_Lay, Odd: 2, Amount: 4, WinPL: -4, LosePL: 4}}}},
+			{Selection: betting.Selection{ID: 6, Bets: []betting.Bet{
+				{Type: betting.BetType_Back, Odd: 7.5, Amount: 2, WinPL: 13, LosePL: -2, PriceReduced: true}}}},
+		}},
+	}
+
+	assert.Equal(t, expected, markets)
+}
+
+func TestRecordBet(t *testing.T) {
+	tests := map[string]struct {
+		record      ledger.Record
+		expectedBet betting.Bet
+	}{
+		"bet: not reduced": {
+			record:      ledger.Record{Side: betting.BetType_Back, PriceRequested: 3, PriceMatched: 3.05, SizeMatched: 10},
+			expectedBet: betting.Bet{Type: betting.BetType_Back, Odd: 3.05, Amount: 10, WinPL: 20.5, LosePL: -10}},
+		"bet: reduced": {
+			record:      ledger.Record{Side: betting.BetType_Back, PriceRequested: 10, PriceMatched: 7.5, SizeMatched: 2, PriceReduced: true},
+			expectedBet: betting.Bet{Type: betting.BetType_Back, Odd: 7.5, Amount: 2, WinPL: 13, LosePL: -2, PriceReduced: true}},
+		"bet: reduced after price improvement": {
+			record:      ledger.Record{Side: betting.BetType_Back, PriceRequested: 2, PriceMatched: 3.015, SizeMatched: 10, PriceReduced: true},
+			expectedBet: betting.Bet{Type: betting.BetType_Back, Odd: 3.015, Amount: 10, WinPL: 20.15, LosePL: -10, PriceReduced: true}},
+		"bet: starting price": {
+			record: ledger.Record{Side: betting.BetType_Lay, OrderType: betting.OrderType_MarketOnClose, PriceMatched: 4.37, SizeMatched: 5},
+			expectedBet: betting.Bet{Type: betting.BetType_Lay, Odd: 4.37, Amount: 5, WinPL: -16.85, LosePL: 5,
+				OrderType: betting.OrderType_MarketOnClose}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			bet := test.record.Bet()
+			assert.Equal(t, test.expectedBet.Type, bet.Type)
+			assert.InDelta(t, test.expectedBet.Odd, bet.Odd, 1e-9)
+			assert.InDelta(t, test.expectedBet.Amount, bet.Amount, 1e-9)
+			assert.InDelta(t, test.expectedBet.WinPL, bet.WinPL, 1e-9)
+			assert.InDelta(t, test.expectedBet.LosePL, bet.LosePL, 1e-9)
+			assert.Equal(t, test.expectedBet.ReductionFactor, bet.ReductionFactor)
+			assert.Equal(t, test.expectedBet.PriceReduced, bet.PriceReduced)
+			assert.Equal(t, test.expectedBet.OrderType, bet.OrderType)
+
+			selection := betting.Selection{Bets: []betting.Bet{bet}, CurrentBackOdd: 3, CurrentLayOdd: 3.05}
+			_, err := betting.GreenBookSelection(selection)
+			assert.NoError(t, err)
+		})
+	}
+}